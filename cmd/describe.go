@@ -1,15 +1,40 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/sgracia13/mlb-cli/internal/gamelog"
+	"github.com/sgracia13/mlb-cli/internal/matchup"
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
 )
 
 var (
 	// Flags for describe subcommands
-	statSeasonFlag string
+	statSeasonFlag   string
+	gameLiveFlag     bool
+	gameWatchFlag    bool
+	gameIntervalFlag time.Duration
+
+	gameLogSeasonFlag  string
+	gameLogStartFlag   string
+	gameLogEndFlag     string
+	gameLogWindowsFlag []int
+
+	splitsSeasonFlag string
+	splitsGroupFlag  string
+	splitsFlag       []string
+
+	matchupBatterFlag  string
+	matchupPitcherFlag string
+	matchupGameFlag    string
+	matchupTeamFlag    string
 )
 
 // describeCmd represents the describe command group
@@ -21,11 +46,21 @@ var describeCmd = &cobra.Command{
 Available resources:
   player   Search for and display player information
   stats    Display detailed statistics for a player
+  game     Show a game's score and live situation
+  boxscore Show batting and pitching lines for a game
+  gamelog  Show a player's game-by-game lines and recent form
+  splits   Show a player's stats by situation, e.g. vs LHP/RHP
+  matchup  Show a batter's history against a pitcher
 
 Examples:
   mlb describe player "Shohei Ohtani"
   mlb describe stats 660271
-  mlb describe stats 660271 --season 2024`,
+  mlb describe stats 660271 --season 2024
+  mlb describe game 745432 --live
+  mlb describe boxscore 745432
+  mlb describe gamelog 660271 --season 2024
+  mlb describe splits 660271 --season 2024
+  mlb describe matchup --batter judge --pitcher "chris sale"`,
 	Aliases: []string{"desc", "d"},
 }
 
@@ -46,7 +81,7 @@ Examples:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		name := strings.Join(args, " ")
 
-		players, err := GetAPIClient().SearchPlayer(name)
+		players, err := GetAPIClient().SearchPlayer(cmd.Context(), name)
 		if err != nil {
 			return fmt.Errorf("failed to search player: %w", err)
 		}
@@ -72,20 +107,409 @@ Examples:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		playerID := args[0]
 
-		stats, err := GetAPIClient().GetPlayerStats(playerID)
+		stats, err := GetAPIClient().GetPlayerStats(cmd.Context(), playerID)
 		if err != nil {
 			return fmt.Errorf("failed to get stats: %w", err)
 		}
-		return GetFormatter().PrintStats(stats, statSeasonFlag)
+		return GetFormatter().PrintStats(stats, statSeasonFlag, constants)
+	},
+}
+
+// gameCmd represents the 'describe game' command
+var gameCmd = &cobra.Command{
+	Use:   "game [gamePk]",
+	Short: "Show a game's score and live situation",
+	Long: `Show the linescore and status of a game.
+
+Use the game ID shown by 'mlb get schedule -o wide'. With --live, the
+current inning, count, outs, runners on base, batter and pitcher are
+shown as well. With --watch, the game is polled and redrawn until it is
+final; press Ctrl+C to stop. The polling --interval must be at least 5s,
+since the live feed is cached that long.
+
+Examples:
+  mlb describe game 745432
+  mlb describe game 745432 --live
+  mlb describe game 745432 --watch --interval 30s`,
+	Aliases: []string{"games", "g"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gamePk := args[0]
+		if _, err := strconv.Atoi(gamePk); err != nil {
+			return api.InvalidInput("invalid game ID: %s", gamePk)
+		}
+		if gameIntervalFlag < api.LiveFeedTTL {
+			// The live feed is cached this long, so faster polling only
+			// redraws the same snapshot
+			return api.InvalidInput("--interval must be at least %s, got %s", api.LiveFeedTTL, gameIntervalFlag)
+		}
+
+		ctx := cmd.Context()
+		for {
+			feed, err := GetAPIClient().GetGameFeed(ctx, gamePk)
+			if err != nil {
+				if gameWatchFlag && ctx.Err() != nil {
+					return nil // stopped watching with Ctrl+C
+				}
+				return fmt.Errorf("failed to get game: %w", err)
+			}
+
+			if gameWatchFlag {
+				GetFormatter().Redraw()
+			}
+			if err := GetFormatter().PrintGame(feed, gameLiveFlag || gameWatchFlag); err != nil {
+				return err
+			}
+			if !gameWatchFlag || feed.GameData.Status.IsFinal() {
+				return nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(gameIntervalFlag):
+			}
+		}
+	},
+}
+
+// boxscoreCmd represents the 'describe boxscore' command
+var boxscoreCmd = &cobra.Command{
+	Use:   "boxscore [gamePk]",
+	Short: "Show batting and pitching lines for a game",
+	Long: `Show the box score of a game: the linescore by inning, the winning,
+losing and saving pitchers, and each team's batting and pitching lines.
+
+Batting lines show AB, R, H, RBI, BB, SO and season AVG; pitching lines
+show IP, H, R, ER, BB, SO and pitch count. Substitutes are indented
+under the player they replaced. Use -o wide for HR, LOB, OPS, strikes
+and season ERA.
+
+Examples:
+  mlb describe boxscore 745432
+  mlb describe boxscore 745432 -o wide
+  mlb describe box 745432 -o json`,
+	Aliases: []string{"box", "bs"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gamePk := args[0]
+		if _, err := strconv.Atoi(gamePk); err != nil {
+			return api.InvalidInput("invalid game ID: %s", gamePk)
+		}
+
+		feed, err := GetAPIClient().GetGameFeed(cmd.Context(), gamePk)
+		if err != nil {
+			return fmt.Errorf("failed to get box score: %w", err)
+		}
+		return GetFormatter().PrintBoxscore(feed)
+	},
+}
+
+// gameLogCmd represents the 'describe gamelog' command
+var gameLogCmd = &cobra.Command{
+	Use:   "gamelog [player]",
+	Short: "Show a player's game-by-game lines and recent form",
+	Long: `Show a player's hitting and pitching line for every game of a season,
+followed by their combined lines over the last 7, 15 and 30 days and the
+whole range.
+
+The player can be given by name or ID. The rolling windows end on --end,
+or today for the current season, or the player's last game for a past
+one. Use --start and --end to limit the games shown, and -o wide for more
+columns and each game's ID.
+
+Examples:
+  mlb describe gamelog 660271                          # This season
+  mlb describe gamelog "Shohei Ohtani" --season 2024
+  mlb describe gamelog 660271 --start 2024-08-01 --end 2024-08-31
+  mlb describe gamelog judge --windows 5,10            # Last 5 and 10 days`,
+	Aliases: []string{"gamelogs", "log", "gl"},
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, date := range []string{gameLogStartFlag, gameLogEndFlag} {
+			if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+				return api.InvalidInput("invalid date: %s (use YYYY-MM-DD)", date)
+			}
+		}
+		if gameLogStartFlag != "" && gameLogEndFlag != "" && gameLogEndFlag < gameLogStartFlag {
+			return api.InvalidInput("--end %s is before --start %s", gameLogEndFlag, gameLogStartFlag)
+		}
+		for _, days := range gameLogWindowsFlag {
+			if days <= 0 {
+				return api.InvalidInput("invalid window: %d (use a number of days)", days)
+			}
+		}
+
+		// The season defaults to the one the date range falls in
+		season := gameLogSeasonFlag
+		switch {
+		case season != "":
+		case gameLogStartFlag != "":
+			season = gameLogStartFlag[:4]
+		case gameLogEndFlag != "":
+			season = gameLogEndFlag[:4]
+		default:
+			season = today()[:4]
+		}
+
+		asOf := gameLogEndFlag
+		if asOf == "" && season == today()[:4] {
+			asOf = today()
+		}
+
+		player, err := resolvePlayer(cmd.Context(), strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+
+		resp, err := GetAPIClient().GetGameLog(cmd.Context(), strconv.Itoa(player.ID), season)
+		if err != nil {
+			return fmt.Errorf("failed to get game log: %w", err)
+		}
+		log := gamelog.Build(resp, season, gameLogStartFlag, gameLogEndFlag, asOf, gameLogWindowsFlag)
+		return GetFormatter().PrintGameLog(log)
+	},
+}
+
+// splitsCmd represents the 'describe splits' command
+var splitsCmd = &cobra.Command{
+	Use:   "splits [player]",
+	Short: "Show a player's stats by situation, e.g. vs LHP/RHP",
+	Long: `Show a player's season stats broken down by situation: vs left- and
+right-handed pitching (or batting, for pitchers), home and away, day and
+night, by month, and with the bases empty or runners in scoring position.
+
+The player can be given by name or ID. Use --split to pick categories and
+-o wide for ISO, BABIP, K%, BB% and wOBA (FIP for pitchers).
+
+Examples:
+  mlb describe splits 660271                        # This season's hitting
+  mlb describe splits "Shohei Ohtani" --season 2024
+  mlb describe splits judge --split platoon,runners
+  mlb describe splits 543037 --group pitching -o wide`,
+	Aliases: []string{"split", "sp"},
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query := api.SplitsQuery{
+			Season: splitsSeasonFlag,
+			Group:  strings.ToLower(splitsGroupFlag),
+		}
+		if query.Season == "" {
+			query.Season = today()[:4]
+		}
+		if query.Group != models.GroupHitting && query.Group != models.GroupPitching {
+			return api.InvalidInput("unknown group: %s (use hitting, pitching)", splitsGroupFlag)
+		}
+		codes, err := api.ResolveSplits(splitsFlag)
+		if err != nil {
+			return err
+		}
+		query.SitCodes = codes
+
+		player, err := resolvePlayer(cmd.Context(), strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+		query.PlayerID = strconv.Itoa(player.ID)
+
+		splits, err := GetAPIClient().GetSplits(cmd.Context(), query)
+		if err != nil {
+			return fmt.Errorf("failed to get splits: %w", err)
+		}
+		return GetFormatter().PrintSplits(splits, query, constants)
 	},
 }
 
+// matchupCmd represents the 'describe matchup' command
+var matchupCmd = &cobra.Command{
+	Use:   "matchup",
+	Short: "Show a batter's history against a pitcher",
+	Long: `Show how a batter has fared against a pitcher: their career head-to-head
+line (PA, H, HR, BB, SO, AVG, OPS) and a breakdown by season.
+
+Players can be given by name or ID. With --game, every batter in each
+team's lineup (or roster, before lineups are posted) is shown against the
+opposing probable starter; use --team to show one side only.
+
+Examples:
+  mlb describe matchup --batter "Aaron Judge" --pitcher "Chris Sale"
+  mlb describe matchup -b 592450 -p 519242 -o wide
+  mlb describe matchup --game 745432
+  mlb describe matchup --game 745432 --team NYY`,
+	Aliases: []string{"matchups", "vs"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		if matchupGameFlag != "" {
+			return printLineupMatchups(ctx)
+		}
+		if matchupTeamFlag != "" {
+			return api.InvalidInput("--team requires --game")
+		}
+
+		batter, err := resolvePlayer(ctx, matchupBatterFlag)
+		if err != nil {
+			return err
+		}
+		pitcher, err := resolvePlayer(ctx, matchupPitcherFlag)
+		if err != nil {
+			return err
+		}
+
+		line, err := matchup.Get(ctx, GetAPIClient(), batter, pitcher)
+		if err != nil {
+			return fmt.Errorf("failed to get matchup: %w", err)
+		}
+		return GetFormatter().PrintMatchup(line)
+	},
+}
+
+// printLineupMatchups shows each side of a game against the opposing
+// probable starter
+func printLineupMatchups(ctx context.Context) error {
+	if _, err := strconv.Atoi(matchupGameFlag); err != nil {
+		return api.InvalidInput("invalid game ID: %s", matchupGameFlag)
+	}
+
+	feed, err := GetAPIClient().GetGameFeed(ctx, matchupGameFlag)
+	if err != nil {
+		return fmt.Errorf("failed to get game: %w", err)
+	}
+
+	teams := feed.LiveData.Boxscore.Teams
+	probables := feed.GameData.ProbablePitchers
+	away, home := true, true
+	if matchupTeamFlag != "" {
+		switch strings.ToLower(matchupTeamFlag) {
+		case "away":
+			home = false
+		case "home":
+			away = false
+		default:
+			teamID, err := api.ResolveTeamID(matchupTeamFlag)
+			if err != nil {
+				return err
+			}
+			away = teamID == strconv.Itoa(teams.Away.Team.ID)
+			home = teamID == strconv.Itoa(teams.Home.Team.ID)
+			if !away && !home {
+				return api.InvalidInput("%s is not playing in game %s", matchupTeamFlag, matchupGameFlag)
+			}
+		}
+	}
+
+	// Each lineup faces the other team's starter
+	var sides []*matchup.Side
+	if away {
+		side, err := matchup.Lineup(ctx, GetAPIClient(), teams.Away, probables.Home)
+		if err != nil {
+			return fmt.Errorf("failed to get matchups: %w", err)
+		}
+		sides = append(sides, side)
+	}
+	if home {
+		side, err := matchup.Lineup(ctx, GetAPIClient(), teams.Home, probables.Away)
+		if err != nil {
+			return fmt.Errorf("failed to get matchups: %w", err)
+		}
+		sides = append(sides, side)
+	}
+	return GetFormatter().PrintLineupMatchups(feed, sides)
+}
+
+// resolvePlayer turns a player ID or name into a player. A name must match
+// one player, preferring an exact match and then active players.
+func resolvePlayer(ctx context.Context, input string) (models.PersonRef, error) {
+	if id, err := strconv.Atoi(input); err == nil {
+		return models.PersonRef{ID: id}, nil
+	}
+
+	resp, err := GetAPIClient().SearchPlayer(ctx, input)
+	if err != nil {
+		return models.PersonRef{}, fmt.Errorf("failed to search player: %w", err)
+	}
+
+	candidates := resp.People
+	if len(candidates) == 0 {
+		return models.PersonRef{}, api.NotFound("no players found matching %q", input)
+	}
+	for _, narrow := range []func(models.Player) bool{
+		func(p models.Player) bool { return strings.EqualFold(p.FullName, input) },
+		func(p models.Player) bool { return p.Active },
+	} {
+		var matched []models.Player
+		for _, p := range candidates {
+			if narrow(p) {
+				matched = append(matched, p)
+			}
+		}
+		if len(matched) > 0 {
+			candidates = matched
+		}
+	}
+
+	if len(candidates) > 1 {
+		names := make([]string, 0, len(candidates))
+		for _, p := range candidates {
+			names = append(names, fmt.Sprintf("%s (%d)", p.FullName, p.ID))
+		}
+		return models.PersonRef{}, api.InvalidInput("%q matches several players, use an ID: %s", input, strings.Join(names, ", "))
+	}
+	return models.PersonRef{ID: candidates[0].ID, FullName: candidates[0].FullName}, nil
+}
+
 func init() {
 	// Add subcommands to 'describe'
 	describeCmd.AddCommand(playerCmd)
 	describeCmd.AddCommand(statsCmd)
+	describeCmd.AddCommand(gameCmd)
+	describeCmd.AddCommand(boxscoreCmd)
+	describeCmd.AddCommand(gameLogCmd)
+	describeCmd.AddCommand(splitsCmd)
+	describeCmd.AddCommand(matchupCmd)
 
 	// Flags for stats
 	statsCmd.Flags().StringVarP(&statSeasonFlag, "season", "s", "",
 		"Filter stats by season year")
+
+	// Flags for game
+	gameCmd.Flags().BoolVar(&gameLiveFlag, "live", false,
+		"Show the current inning, count, runners and matchup")
+	gameCmd.Flags().BoolVarP(&gameWatchFlag, "watch", "w", false,
+		"Poll and redraw until the game is final (implies --live)")
+	gameCmd.Flags().DurationVar(&gameIntervalFlag, "interval", 15*time.Second,
+		"Polling interval for --watch, at least 5s (the live feed cache lifetime)")
+
+	// Flags for gamelog
+	gameLogCmd.Flags().StringVarP(&gameLogSeasonFlag, "season", "s", "",
+		"Season year (default: current season, or the season of --start)")
+	gameLogCmd.Flags().StringVar(&gameLogStartFlag, "start", "",
+		"Only show games on or after this date (YYYY-MM-DD)")
+	gameLogCmd.Flags().StringVar(&gameLogEndFlag, "end", "",
+		"Only show games on or before this date (YYYY-MM-DD)")
+	gameLogCmd.Flags().IntSliceVar(&gameLogWindowsFlag, "windows", gamelog.DefaultWindows,
+		"Rolling windows in days, e.g. 7,15,30")
+
+	// Flags for splits
+	splitsCmd.Flags().StringVarP(&splitsSeasonFlag, "season", "s", "",
+		"Season year (default: current season)")
+	splitsCmd.Flags().StringVarP(&splitsGroupFlag, "group", "g", models.GroupHitting,
+		"Stat group: hitting or pitching")
+	splitsCmd.Flags().StringSliceVar(&splitsFlag, "split", nil,
+		"Split categories: "+strings.Join(api.SplitCategoryNames, ", ")+" (default: all)")
+
+	// Flags for matchup
+	matchupCmd.Flags().StringVarP(&matchupBatterFlag, "batter", "b", "",
+		"Batter name or ID")
+	matchupCmd.Flags().StringVarP(&matchupPitcherFlag, "pitcher", "p", "",
+		"Pitcher name or ID")
+	matchupCmd.Flags().StringVarP(&matchupGameFlag, "game", "g", "",
+		"Game ID: show both lineups against the opposing probable starters")
+	matchupCmd.Flags().StringVar(&matchupTeamFlag, "team", "",
+		"With --game, only this team's batters: abbreviation, home or away")
+	matchupCmd.MarkFlagsRequiredTogether("batter", "pitcher")
+	matchupCmd.MarkFlagsOneRequired("batter", "game")
+	matchupCmd.MarkFlagsMutuallyExclusive("batter", "game")
+	matchupCmd.MarkFlagsMutuallyExclusive("pitcher", "game")
 }
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sgracia13/mlb-cli/internal/config"
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// execute runs the CLI with args against fixtures and an empty config
+func execute(t *testing.T, fixtures api.DataSource, args ...string) error {
+	t.Helper()
+	t.Setenv(config.EnvConfig, filepath.Join(t.TempDir(), "config.yaml"))
+
+	old := apiClient
+	SetAPIClient(fixtures)
+	defer SetAPIClient(old)
+
+	rootCmd.SilenceUsage = false
+	rootCmd.SetArgs(args)
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetErr(io.Discard)
+	return rootCmd.ExecuteContext(context.Background())
+}
+
+func TestResolvePlayer(t *testing.T) {
+	fixtures := api.NewFixtureClient()
+	fixtures.Players["judge"] = &models.PlayerSearchResponse{People: []models.Player{
+		{ID: 592450, FullName: "Aaron Judge", Active: true},
+	}}
+	fixtures.Players["will-smith"] = &models.PlayerSearchResponse{People: []models.Player{
+		{ID: 669257, FullName: "Will Smith", Active: true},
+		{ID: 519293, FullName: "Will Smith", Active: true},
+		{ID: 456789, FullName: "Will Smith", Active: false},
+	}}
+	fixtures.Players["chris-sale"] = &models.PlayerSearchResponse{People: []models.Player{
+		{ID: 519242, FullName: "Chris Sale", Active: true},
+		{ID: 111111, FullName: "Chris Saleh", Active: false},
+	}}
+	fixtures.Players["nobody"] = &models.PlayerSearchResponse{}
+
+	old := apiClient
+	SetAPIClient(fixtures)
+	defer SetAPIClient(old)
+
+	tests := []struct {
+		input string
+		want  models.PersonRef
+		kind  api.ErrorKind
+	}{
+		{"592450", models.PersonRef{ID: 592450}, api.KindUnknown},
+		{"judge", models.PersonRef{ID: 592450, FullName: "Aaron Judge"}, api.KindUnknown},
+		{"chris sale", models.PersonRef{ID: 519242, FullName: "Chris Sale"}, api.KindUnknown},
+		{"will smith", models.PersonRef{}, api.KindInvalidInput},
+		{"nobody", models.PersonRef{}, api.KindNotFound},
+		{"missing", models.PersonRef{}, api.KindNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := resolvePlayer(context.Background(), tt.input)
+			if kind := api.KindOf(err); kind != tt.kind {
+				t.Fatalf("resolvePlayer(%q) error = %v, want kind %v", tt.input, err, tt.kind)
+			}
+			if got != tt.want {
+				t.Errorf("resolvePlayer(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGameInterval(t *testing.T) {
+	tests := []struct {
+		interval time.Duration
+		kind     api.ErrorKind
+	}{
+		{-time.Second, api.KindInvalidInput},
+		{0, api.KindInvalidInput},
+		{time.Second, api.KindInvalidInput},
+		// Valid intervals get as far as fetching the game
+		{api.LiveFeedTTL, api.KindNotFound},
+		{time.Minute, api.KindNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.interval.String(), func(t *testing.T) {
+			err := execute(t, api.NewFixtureClient(), "describe", "game", "745444", "--watch", "--interval", tt.interval.String())
+			if kind := api.KindOf(err); kind != tt.kind {
+				t.Errorf("--interval %s: error = %v, want kind %v", tt.interval, err, tt.kind)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sgracia13/mlb-cli/internal/output"
+	"github.com/sgracia13/mlb-cli/pkg/api"
+)
+
+// Exit codes let scripts tell failure modes apart without parsing messages
+const (
+	ExitOK           = 0
+	ExitError        = 1 // anything not covered below
+	ExitInvalidInput = 2 // bad flags, arguments or team names
+	ExitNotFound     = 3 // no such team, player or game
+	ExitRateLimited  = 4 // the API kept answering 429
+	ExitUpstream     = 5 // the API kept answering 5xx
+	ExitNetwork      = 6 // the API could not be reached
+	ExitDecode       = 7 // the API response could not be parsed
+	ExitInterrupted  = 130
+)
+
+// usageError marks errors caused by invalid command-line usage
+type usageError struct {
+	err error
+}
+
+func (e *usageError) Error() string { return e.err.Error() }
+func (e *usageError) Unwrap() error { return e.err }
+
+// exitCode maps an error returned by a command to the process exit code
+func exitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	if errors.Is(err, context.Canceled) {
+		return ExitInterrupted
+	}
+	var usageErr *usageError
+	if errors.As(err, &usageErr) {
+		return ExitInvalidInput
+	}
+
+	switch api.KindOf(err) {
+	case api.KindInvalidInput:
+		return ExitInvalidInput
+	case api.KindNotFound:
+		return ExitNotFound
+	case api.KindRateLimited:
+		return ExitRateLimited
+	case api.KindUpstream:
+		return ExitUpstream
+	case api.KindNetwork:
+		return ExitNetwork
+	case api.KindDecode:
+		return ExitDecode
+	}
+	return ExitError
+}
+
+// errorKind names the failure in machine-readable output
+func errorKind(err error) string {
+	switch exitCode(err) {
+	case ExitInterrupted:
+		return "interrupted"
+	case ExitInvalidInput:
+		return api.KindInvalidInput.String()
+	}
+	return api.KindOf(err).String()
+}
+
+// errorJSON is written to stderr instead of plain text when -o json is used
+type errorJSON struct {
+	Error struct {
+		Kind     string `json:"kind"`
+		Message  string `json:"message"`
+		Status   int    `json:"status,omitempty"`
+		ExitCode int    `json:"exitCode"`
+	} `json:"error"`
+}
+
+// printError reports err to w, normally stderr, in the selected output
+// format
+func printError(w io.Writer, err error) {
+	if output.ParseFormat(outputFormat) != output.FormatJSON {
+		fmt.Fprintln(w, "Error:", err)
+		return
+	}
+
+	var out errorJSON
+	out.Error.Kind = errorKind(err)
+	out.Error.Message = err.Error()
+	out.Error.ExitCode = exitCode(err)
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) {
+		out.Error.Status = apiErr.StatusCode
+	}
+
+	data, _ := json.MarshalIndent(out, "", "  ")
+	fmt.Fprintln(w, string(data))
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/sgracia13/mlb-cli/pkg/api"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   int
+		kind   string
+		status int
+	}{
+		{"not found", fmt.Errorf("failed to get team: %w", api.NotFound("no team %q", "XYZ")), ExitNotFound, "not_found", 0},
+		{"rate limited", &api.Error{Kind: api.KindRateLimited, StatusCode: 429}, ExitRateLimited, "rate_limited", 429},
+		{"upstream", &api.Error{Kind: api.KindUpstream, StatusCode: 503}, ExitUpstream, "upstream", 503},
+		{"network", &api.Error{Kind: api.KindNetwork, Err: errors.New("connection refused")}, ExitNetwork, "network", 0},
+		{"decode", &api.Error{Kind: api.KindDecode, Err: errors.New("unexpected EOF")}, ExitDecode, "decode", 0},
+		{"invalid input", api.InvalidInput("invalid date: %s", "July"), ExitInvalidInput, "invalid_input", 0},
+		{"usage", &usageError{err: errors.New(`unknown flag: --bogus`)}, ExitInvalidInput, "invalid_input", 0},
+		{"interrupted", fmt.Errorf("failed to get schedule: %w", context.Canceled), ExitInterrupted, "interrupted", 0},
+		{"other", errors.New("boom"), ExitError, "unknown", 0},
+	}
+
+	old := outputFormat
+	outputFormat = "json"
+	defer func() { outputFormat = old }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.err); got != tt.code {
+				t.Errorf("exitCode() = %d, want %d", got, tt.code)
+			}
+
+			var buf bytes.Buffer
+			printError(&buf, tt.err)
+
+			var got map[string]map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("printError() wrote invalid JSON %q: %v", buf.String(), err)
+			}
+			want := map[string]any{
+				"kind":     tt.kind,
+				"message":  tt.err.Error(),
+				"exitCode": float64(tt.code),
+			}
+			if tt.status != 0 {
+				want["status"] = float64(tt.status)
+			}
+			if len(got) != 1 || !reflect.DeepEqual(got["error"], want) {
+				t.Errorf("printError() = %s, want {\"error\": %v}", buf.String(), want)
+			}
+		})
+	}
+
+	if got := exitCode(nil); got != ExitOK {
+		t.Errorf("exitCode(nil) = %d, want %d", got, ExitOK)
+	}
+}
+
+func TestPrintErrorText(t *testing.T) {
+	old := outputFormat
+	outputFormat = "table"
+	defer func() { outputFormat = old }()
+
+	var buf bytes.Buffer
+	printError(&buf, api.NotFound("no team %q", "XYZ"))
+	if want := "Error: no team \"XYZ\"\n"; buf.String() != want {
+		t.Errorf("printError() = %q, want %q", buf.String(), want)
+	}
+}
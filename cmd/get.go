@@ -1,12 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
-	"mlb-cli/internal/api"
+	"github.com/sgracia13/mlb-cli/internal/clinch"
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
 )
 
 var (
@@ -14,6 +19,20 @@ var (
 	seasonFlag string
 	dateFlag   string
 	teamFlag   string
+
+	standingsTypeFlag   string
+	standingsDateFlag   string
+	standingsClinchFlag bool
+
+	scheduleStartFlag    string
+	scheduleEndFlag      string
+	scheduleTeamFlag     string
+	scheduleGameTypeFlag string
+
+	playsGameFlag    string
+	playsScoringFlag bool
+	playsInningFlag  int
+	playsPlayerFlag  string
 )
 
 // getCmd represents the get command group
@@ -25,14 +44,16 @@ var getCmd = &cobra.Command{
 Available resources:
   teams       List all MLB teams
   standings   Display division standings
-  schedule    Show games for a specific date
+  schedule    Show games for a date or date range
   roster      Display a team's active roster
+  plays       List the plate appearances in a game
 
 Examples:
   mlb get teams
   mlb get standings --season 2024
   mlb get schedule --date 2024-10-15
-  mlb get roster --team LAD`,
+  mlb get roster --team LAD
+  mlb get plays --game 745432`,
 	Aliases: []string{"g"},
 }
 
@@ -43,7 +64,7 @@ var teamsCmd = &cobra.Command{
 	Long:    `Display a list of all MLB teams with their abbreviations and divisions.`,
 	Aliases: []string{"team", "t"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		teams, err := GetAPIClient().GetTeams()
+		teams, err := GetAPIClient().GetTeams(cmd.Context())
 		if err != nil {
 			return fmt.Errorf("failed to get teams: %w", err)
 		}
@@ -55,57 +76,173 @@ var teamsCmd = &cobra.Command{
 var standingsCmd = &cobra.Command{
 	Use:   "standings",
 	Short: "Display division standings",
-	Long: `Display MLB division standings for a specific season.
+	Long: `Display MLB standings for a specific season.
 
-If no season is specified, the current year is used.
+If no season is specified, the current year is used. Use --type for
+wild card, league-wide, spring training or postseason standings, and
+--date for the standings as of a given day. Wild card standings show
+games back of the final playoff spot. --clinch shows each team's magic
+and elimination numbers for the division and wild card races.
 
 Examples:
   mlb get standings
   mlb get standings --season 2024
-  mlb get standings -s 2023`,
+  mlb get standings -s 2023
+  mlb get standings --type wildcard
+  mlb get standings --type league --date 2024-07-01
+  mlb get standings --clinch`,
 	Aliases: []string{"standing", "stand", "st"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		season := seasonFlag
-		if season == "" {
-			season = time.Now().Format("2006")
+		query := api.StandingsQuery{Season: seasonFlag, Date: standingsDateFlag}
+
+		if query.Date != "" {
+			date, err := time.Parse("2006-01-02", query.Date)
+			if err != nil {
+				return api.InvalidInput("invalid date: %s (use YYYY-MM-DD)", query.Date)
+			}
+			if query.Season == "" {
+				query.Season = date.Format("2006")
+			}
+		}
+		if query.Season == "" {
+			query.Season = time.Now().In(location).Format("2006")
+		}
+
+		if standingsTypeFlag != "" {
+			standingsType, err := api.ResolveStandingsType(standingsTypeFlag)
+			if err != nil {
+				return err
+			}
+			query.Type = standingsType
+		}
+
+		label := query.Season
+		if query.Date != "" {
+			label += " as of " + query.Date
 		}
 
-		standings, err := GetAPIClient().GetStandings(season)
+		if standingsClinchFlag {
+			return printClinch(cmd.Context(), query, label)
+		}
+
+		standings, err := GetAPIClient().GetStandings(cmd.Context(), query)
 		if err != nil {
 			return fmt.Errorf("failed to get standings: %w", err)
 		}
-		return GetFormatter().PrintStandings(standings, season)
+		return GetFormatter().PrintStandings(standings, label)
 	},
 }
 
+// printClinch fetches the division and wild card standings for a query,
+// along with the games left to play, and prints the magic and elimination
+// numbers
+func printClinch(ctx context.Context, query api.StandingsQuery, label string) error {
+	query.Type = ""
+	division, err := GetAPIClient().GetStandings(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to get standings: %w", err)
+	}
+
+	query.Type = api.StandingsWildCard
+	wildCard, err := GetAPIClient().GetStandings(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to get wild card standings: %w", err)
+	}
+
+	remaining, err := clinch.GamesRemaining(ctx, GetAPIClient(), query, today())
+	if err != nil {
+		return fmt.Errorf("failed to get remaining schedule: %w", err)
+	}
+
+	statuses := clinch.Compute(division, wildCard, remaining, api.WildCardSpots)
+	return GetFormatter().PrintClinch(division, statuses, label)
+}
+
 // scheduleCmd represents the 'get schedule' command
 var scheduleCmd = &cobra.Command{
 	Use:   "schedule",
-	Short: "Show games for a specific date",
-	Long: `Display the MLB game schedule for a specific date.
+	Short: "Show games for a date or date range",
+	Long: `Display the MLB game schedule for a date or a range of dates.
 
-If no date is specified, today's date is used.
+If no date is specified, today's date in the --tz time zone is used.
+Start times are shown in that zone too. Use --start and --end for
+a range; games are grouped by date. Narrow the list with --team and
+--game-type (regular, postseason, spring or exhibition).
 Date format: YYYY-MM-DD
 
 Examples:
   mlb get schedule
   mlb get schedule --date 2024-10-15
-  mlb get schedule -d 2024-07-04`,
+  mlb get schedule -d 2024-07-04
+  mlb get schedule --team LAD --start 2024-09-01 --end 2024-09-30
+  mlb get schedule --start 2024-10-01 --end 2024-10-31 --game-type postseason`,
 	Aliases: []string{"games", "sched", "sc"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		date := dateFlag
-		if date == "" {
-			date = time.Now().Format("2006-01-02")
+		query, label, err := scheduleQuery()
+		if err != nil {
+			return err
 		}
 
-		schedule, err := GetAPIClient().GetSchedule(date)
+		schedule, err := api.LocalSchedule(cmd.Context(), GetAPIClient(), query, location)
 		if err != nil {
 			return fmt.Errorf("failed to get schedule: %w", err)
 		}
-		return GetFormatter().PrintSchedule(schedule, date)
+		return GetFormatter().PrintSchedule(schedule, label)
 	},
 }
 
+// scheduleQuery builds the schedule query from the flags, along with a
+// label describing the dates it covers
+func scheduleQuery() (api.ScheduleQuery, string, error) {
+	var query api.ScheduleQuery
+
+	switch {
+	case scheduleStartFlag != "":
+		query.StartDate = scheduleStartFlag
+		query.EndDate = scheduleEndFlag
+		if query.EndDate == "" {
+			query.EndDate = query.StartDate
+		}
+	case scheduleEndFlag != "":
+		return query, "", api.InvalidInput("--end requires --start")
+	case dateFlag != "":
+		query.StartDate = dateFlag
+	default:
+		query.StartDate = today()
+	}
+
+	for _, date := range []string{query.StartDate, query.EndDate} {
+		if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+			return query, "", api.InvalidInput("invalid date: %s (use YYYY-MM-DD)", date)
+		}
+	}
+	if query.EndDate != "" && query.EndDate < query.StartDate {
+		return query, "", api.InvalidInput("--end %s is before --start %s", query.EndDate, query.StartDate)
+	}
+
+	label := query.StartDate
+	if query.EndDate != "" && query.EndDate != query.StartDate {
+		label += " to " + query.EndDate
+	}
+
+	if scheduleTeamFlag != "" {
+		teamID, err := api.ResolveTeamID(scheduleTeamFlag)
+		if err != nil {
+			return query, "", err
+		}
+		query.TeamID = teamID
+	}
+	if scheduleGameTypeFlag != "" {
+		codes, err := api.ResolveGameType(scheduleGameTypeFlag)
+		if err != nil {
+			return query, "", err
+		}
+		query.GameTypes = codes
+	}
+
+	return query, label, nil
+}
+
 // rosterCmd represents the 'get roster' command
 var rosterCmd = &cobra.Command{
 	Use:   "roster",
@@ -134,7 +271,7 @@ Team abbreviations:
 			return err
 		}
 
-		roster, err := GetAPIClient().GetRoster(teamID)
+		roster, err := GetAPIClient().GetRoster(cmd.Context(), teamID)
 		if err != nil {
 			return fmt.Errorf("failed to get roster: %w", err)
 		}
@@ -142,23 +279,118 @@ Team abbreviations:
 	},
 }
 
+// playsCmd represents the 'get plays' command
+var playsCmd = &cobra.Command{
+	Use:   "plays",
+	Short: "List the plate appearances in a game",
+	Long: `List every plate appearance in a game with the inning, batter,
+pitcher, result, RBI and the score after the play.
+
+Use the game ID shown by 'mlb get schedule -o wide'. Filter with
+--scoring for scoring plays only, --inning for a single inning, or
+--player for plays where a player batted or pitched (name or ID).
+
+Examples:
+  mlb get plays --game 745432
+  mlb get plays --game 745432 --scoring
+  mlb get plays --game 745432 --inning 9 -o wide
+  mlb get plays --game 745432 --player judge`,
+	Aliases: []string{"play", "pbp"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := strconv.Atoi(playsGameFlag); err != nil {
+			return api.InvalidInput("invalid game ID: %s", playsGameFlag)
+		}
+		if playsInningFlag < 0 {
+			return api.InvalidInput("invalid inning: %d", playsInningFlag)
+		}
+
+		var playerID int
+		if playsPlayerFlag != "" {
+			player, err := resolvePlayer(cmd.Context(), playsPlayerFlag)
+			if err != nil {
+				return err
+			}
+			playerID = player.ID
+		}
+
+		feed, err := GetAPIClient().GetGameFeed(cmd.Context(), playsGameFlag)
+		if err != nil {
+			return fmt.Errorf("failed to get plays: %w", err)
+		}
+
+		plays := filterPlays(feed.LiveData.Plays.AllPlays, playsScoringFlag, playsInningFlag, playerID)
+		return GetFormatter().PrintPlays(feed, plays)
+	},
+}
+
+// filterPlays returns the finished plate appearances that match the
+// filters. A zero inning or playerID matches every play.
+func filterPlays(all []models.Play, scoring bool, inning, playerID int) []models.Play {
+	plays := []models.Play{}
+	for _, p := range all {
+		if !p.About.IsComplete && p.Result.Event == "" {
+			continue // the at-bat in progress
+		}
+		if scoring && !p.About.IsScoringPlay {
+			continue
+		}
+		if inning > 0 && p.About.Inning != inning {
+			continue
+		}
+		if playerID != 0 && !p.Involves(playerID) {
+			continue
+		}
+		plays = append(plays, p)
+	}
+	return plays
+}
+
 func init() {
 	// Add subcommands to 'get'
 	getCmd.AddCommand(teamsCmd)
 	getCmd.AddCommand(standingsCmd)
 	getCmd.AddCommand(scheduleCmd)
 	getCmd.AddCommand(rosterCmd)
+	getCmd.AddCommand(playsCmd)
 
 	// Flags for standings
 	standingsCmd.Flags().StringVarP(&seasonFlag, "season", "s", "",
-		"Season year (default: current year)")
+		"Season year (default: current year, or the year of --date)")
+	standingsCmd.Flags().StringVar(&standingsTypeFlag, "type", "",
+		"Standings type: "+strings.Join(api.StandingsTypeNames, ", ")+" (default: division)")
+	standingsCmd.Flags().StringVarP(&standingsDateFlag, "date", "d", "",
+		"Standings as of this date in YYYY-MM-DD format")
+	standingsCmd.Flags().BoolVar(&standingsClinchFlag, "clinch", false,
+		"Show magic and elimination numbers for the division and wild card")
+	standingsCmd.MarkFlagsMutuallyExclusive("type", "clinch")
 
 	// Flags for schedule
 	scheduleCmd.Flags().StringVarP(&dateFlag, "date", "d", "",
 		"Date in YYYY-MM-DD format (default: today)")
+	scheduleCmd.Flags().StringVar(&scheduleStartFlag, "start", "",
+		"First date of a range in YYYY-MM-DD format")
+	scheduleCmd.Flags().StringVar(&scheduleEndFlag, "end", "",
+		"Last date of a range in YYYY-MM-DD format (default: --start)")
+	scheduleCmd.Flags().StringVarP(&scheduleTeamFlag, "team", "t", "",
+		"Only show games for this team (abbreviation or ID)")
+	scheduleCmd.Flags().StringVar(&scheduleGameTypeFlag, "game-type", "",
+		"Only show games of this type: "+strings.Join(api.GameTypeNames, ", "))
+	scheduleCmd.MarkFlagsMutuallyExclusive("date", "start")
+	scheduleCmd.MarkFlagsMutuallyExclusive("date", "end")
 
 	// Flags for roster
 	rosterCmd.Flags().StringVarP(&teamFlag, "team", "t", "",
 		"Team abbreviation (e.g., LAD, NYY) or team ID")
 	rosterCmd.MarkFlagRequired("team")
+
+	// Flags for plays
+	playsCmd.Flags().StringVarP(&playsGameFlag, "game", "g", "",
+		"Game ID (from 'mlb get schedule -o wide')")
+	playsCmd.Flags().BoolVar(&playsScoringFlag, "scoring", false,
+		"Only show plays that scored a run")
+	playsCmd.Flags().IntVarP(&playsInningFlag, "inning", "i", 0,
+		"Only show plays from this inning")
+	playsCmd.Flags().StringVarP(&playsPlayerFlag, "player", "p", "",
+		"Only show plays involving this batter or pitcher (name or ID)")
+	playsCmd.MarkFlagRequired("game")
 }
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+func TestFilterPlays(t *testing.T) {
+	fixtures, err := api.LoadFixtures(os.DirFS("testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	feed, err := fixtures.GetGameFeed(context.Background(), "745444")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const (
+		judge   = 592450
+		glasnow = 607192
+		holmes  = 605280
+		smith   = 669257
+	)
+
+	// The last play is Smith's at-bat in progress, which is never listed
+	tests := []struct {
+		name     string
+		scoring  bool
+		inning   int
+		playerID int
+		want     []int // at-bat indexes
+	}{
+		{"all", false, 0, 0, []int{0, 1, 2, 3, 4}},
+		{"scoring", true, 0, 0, []int{1, 4}},
+		{"inning", false, 9, 0, []int{3, 4}},
+		{"batter", false, 0, judge, []int{0, 3}},
+		{"pitcher", false, 0, glasnow, []int{0, 1}},
+		{"scoring against a pitcher", true, 0, holmes, []int{4}},
+		{"only the at-bat in progress", false, 0, smith, []int{}},
+		{"inning with no plays", false, 5, 0, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []int{}
+			for _, p := range filterPlays(feed.LiveData.Plays.AllPlays, tt.scoring, tt.inning, tt.playerID) {
+				got = append(got, p.About.AtBatIndex)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("filterPlays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaysPlayer(t *testing.T) {
+	fixtures, err := api.LoadFixtures(os.DirFS("testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A name is searched like every other player flag instead of matched
+	// as a substring, so an ambiguous one is rejected
+	fixtures.Players["smith"] = &models.PlayerSearchResponse{People: []models.Player{
+		{ID: 669257, FullName: "Will Smith", Active: true},
+		{ID: 596019, FullName: "Dominic Smith", Active: true},
+	}}
+
+	err = execute(t, fixtures, "get", "plays", "--game", "745444", "--player", "smith")
+	if api.KindOf(err) != api.KindInvalidInput {
+		t.Errorf("get plays --player smith: error = %v, want invalid input", err)
+	}
+}
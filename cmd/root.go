@@ -1,21 +1,38 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 
-	"mlb-cli/internal/api"
-	"mlb-cli/internal/output"
-	"mlb-cli/internal/tui"
+	"github.com/sgracia13/mlb-cli/internal/config"
+	"github.com/sgracia13/mlb-cli/internal/output"
+	"github.com/sgracia13/mlb-cli/internal/sabermetrics"
+	"github.com/sgracia13/mlb-cli/internal/tui"
+	"github.com/sgracia13/mlb-cli/pkg/api"
 )
 
 var (
 	outputFormat string
+	noCache      bool
+	cacheTTL     time.Duration
+	maxRetries   int
+	rateLimit    float64
+	recordDir    string
+	replayDir    string
+	verbosity    int
+	profileName  string
+	timezone     string
 
-	apiClient *api.Client
+	apiClient api.DataSource
 	formatter *output.Formatter
+	location  = time.Local
+	constants = sabermetrics.DefaultConstants
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -38,14 +55,51 @@ Examples:
 
   mlb describe player "Shohei Ohtani"  # Search for a player
   mlb describe stats 660271            # Player stats by ID`,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		// Initialize shared instances before each command
-		apiClient = api.NewClient()
-		formatter = output.NewFormatter(output.ParseFormat(outputFormat))
+	// Errors are reported by Execute so they can be rendered as JSON
+	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Cobra checks these after the pre-run hooks; check them now so that
+		// every usage mistake is caught before SilenceUsage is set below
+		if err := cmd.ValidateRequiredFlags(); err != nil {
+			return err
+		}
+		if err := cmd.ValidateFlagGroups(); err != nil {
+			return err
+		}
+
+		// Flags and arguments are valid, so any later error is not a usage
+		// problem and shouldn't print the help text
+		cmd.Root().SilenceUsage = true
+
+		// A broken config must not stop commands that never call the API
+		if !needsClient(cmd) {
+			return nil
+		}
+
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+		if location, err = cfg.Location(timezone); err != nil {
+			return err
+		}
+		constants = cfg.SabermetricsTable()
+
+		// Initialize shared instances before each command, keeping any
+		// data source injected with SetAPIClient
+		if apiClient == nil {
+			opts, err := clientOptions(cfg)
+			if err != nil {
+				return err
+			}
+			apiClient = api.NewClient(opts...)
+		}
+		formatter = output.NewFormatter(output.ParseFormat(outputFormat), location)
+		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Launch interactive TUI when no subcommand is provided
-		if err := tui.Run(); err != nil {
+		if err := tui.Run(cmd.Context(), GetAPIClient(), location, constants); err != nil {
 			return fmt.Errorf("failed to start TUI: %w", err)
 		}
 		return nil
@@ -53,10 +107,18 @@ Examples:
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
+// Ctrl+C cancels the command context, aborting any in-flight API request.
 func Execute() {
-	err := rootCmd.Execute()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := rootCmd.ExecuteContext(ctx)
+	stop()
 	if err != nil {
-		os.Exit(1)
+		if !rootCmd.SilenceUsage {
+			// The command never got past flag and argument validation
+			err = &usageError{err: err}
+		}
+		printError(os.Stderr, err)
+		os.Exit(exitCode(err))
 	}
 }
 
@@ -64,19 +126,116 @@ func init() {
 	// Global flags available to all commands
 	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table",
 		"Output format: table, wide, or json")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "",
+		"Config profile to use (default: $MLB_PROFILE or currentProfile)")
+	rootCmd.PersistentFlags().StringVar(&timezone, "tz", "",
+		"Time zone for game times and dates, e.g. Asia/Tokyo (default: config timezone or local)")
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false,
+		"Bypass the on-disk response cache")
+	rootCmd.PersistentFlags().DurationVar(&cacheTTL, "cache-ttl", 0,
+		"Cache lifetime for every response, e.g. 1h (default: per endpoint)")
+	rootCmd.PersistentFlags().IntVar(&maxRetries, "retries", api.DefaultRetryPolicy.MaxRetries,
+		"Retries for rate-limited, failed (5xx) or timed out requests")
+	rootCmd.PersistentFlags().Float64Var(&rateLimit, "rate-limit", 10,
+		"Maximum API requests per second (0 for unlimited)")
+	rootCmd.PersistentFlags().StringVar(&recordDir, "record", "",
+		"Record every API response to this fixture directory")
+	rootCmd.PersistentFlags().StringVar(&replayDir, "replay", "",
+		"Replay API responses from this fixture directory without network access")
+	rootCmd.MarkFlagsMutuallyExclusive("record", "replay")
+	rootCmd.PersistentFlags().IntVarP(&verbosity, "verbosity", "v", 0,
+		"HTTP trace level on stderr: 1 URLs and latency, 2 retries, 3 headers, 4 bodies")
 
 	// Add command groups
 	rootCmd.AddCommand(getCmd)
 	rootCmd.AddCommand(describeCmd)
+	rootCmd.AddCommand(simulateCmd)
 	rootCmd.AddCommand(versionCmd)
 }
 
+// needsClient reports whether cmd talks to the API, and so needs the config
+// and client set up by the pre-run hook. Version, help and shell completion
+// don't.
+func needsClient(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		switch c.Name() {
+		case "version", "help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+			return false
+		}
+	}
+	return true
+}
+
+// loadConfig reads the config file from its default location
+func loadConfig() (*config.Config, error) {
+	path, err := config.DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return config.Load(path)
+}
+
+// clientOptions builds API client options from the config profile and the
+// global flags
+func clientOptions(cfg *config.Config) ([]api.Option, error) {
+	opts := []api.Option{api.WithUserAgent("mlb-cli/" + Version)}
+
+	profile, err := cfg.Profile(profileName)
+	if err != nil {
+		return nil, err
+	}
+	profileOpts, err := profile.ClientOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile: %w", err)
+	}
+	opts = append(opts, profileOpts...)
+
+	retry := api.DefaultRetryPolicy
+	retry.MaxRetries = maxRetries
+	opts = append(opts, api.WithRetry(retry))
+
+	if rateLimit > 0 {
+		opts = append(opts, api.WithRateLimiter(api.NewRateLimiter(rateLimit)))
+	}
+
+	if verbosity > 0 {
+		opts = append(opts, api.WithTrace(os.Stderr, verbosity))
+	}
+
+	if recordDir != "" {
+		opts = append(opts, api.WithRecorder(api.NewTape(recordDir)))
+	}
+	if replayDir != "" {
+		opts = append(opts, api.WithReplay(api.NewTape(replayDir)))
+	}
+
+	if !noCache {
+		// Without a usable cache dir the CLI still works, just uncached
+		if dir, err := api.DefaultCacheDir(); err == nil {
+			opts = append(opts, api.WithCache(api.NewCache(dir, cacheTTL)))
+		}
+	}
+
+	return opts, nil
+}
+
 // GetAPIClient returns the shared API client
-func GetAPIClient() *api.Client {
+func GetAPIClient() api.DataSource {
 	return apiClient
 }
 
+// SetAPIClient replaces the data source used by all commands, e.g. with an
+// api.FixtureClient for tests and offline demos
+func SetAPIClient(client api.DataSource) {
+	apiClient = client
+}
+
 // GetFormatter returns the shared formatter
 func GetFormatter() *output.Formatter {
 	return formatter
 }
+
+// today returns the current date in the selected time zone
+func today() string {
+	return time.Now().In(location).Format("2006-01-02")
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sgracia13/mlb-cli/internal/simulate"
+	"github.com/sgracia13/mlb-cli/pkg/api"
+)
+
+var (
+	// Flags for simulate subcommands
+	simSeasonFlag     string
+	simIterationsFlag int
+	simSeedFlag       uint64
+)
+
+// simulateCmd represents the simulate command group
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Project outcomes by simulation",
+	Long: `Project MLB outcomes by simulating the games left to play.
+
+Available resources:
+  season   Playoff odds from simulating the rest of the regular season
+
+Examples:
+  mlb simulate season
+  mlb simulate season --season 2024 --iterations 10000 --seed 42`,
+	Aliases: []string{"sim"},
+}
+
+// simSeasonCmd represents the 'simulate season' command
+var simSeasonCmd = &cobra.Command{
+	Use:   "season",
+	Short: "Playoff odds from simulating the rest of the season",
+	Long: `Estimate each team's odds of winning its division, earning a wild card
+and getting a first-round bye.
+
+The current standings are played forward through every remaining regular
+season game. Team strength comes from run differential (Pythagorean
+record), and each game is decided by the log5 chance of the home team
+beating the away team. Simulations run in parallel across all CPUs.
+
+Pass --seed to reproduce a run; without it a random seed is used and
+printed with the results.
+
+Examples:
+  mlb simulate season
+  mlb simulate season --iterations 50000
+  mlb simulate season --season 2024 --seed 42 -o json`,
+	Aliases: []string{"seasons", "s"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		now := time.Now().In(location)
+		season := simSeasonFlag
+		if season == "" {
+			season = now.Format("2006")
+		}
+		year, err := strconv.Atoi(season)
+		if err != nil {
+			return api.InvalidInput("invalid season: %s", season)
+		}
+		if simIterationsFlag <= 0 {
+			return api.InvalidInput("--iterations must be positive")
+		}
+
+		seed := simSeedFlag
+		if !cmd.Flags().Changed("seed") {
+			seed = rand.Uint64()
+		}
+
+		standings, err := GetAPIClient().GetStandings(ctx, api.SeasonStandings(season))
+		if err != nil {
+			return fmt.Errorf("failed to get standings: %w", err)
+		}
+		teams := simulate.TeamsFromStandings(standings)
+		if len(teams) == 0 {
+			return api.NotFound("no standings for the %s season", season)
+		}
+
+		// Past seasons are complete, so there is nothing left to play
+		var games []simulate.Game
+		if year >= now.Year() {
+			schedule, err := GetAPIClient().GetSchedule(ctx, api.ScheduleQuery{
+				StartDate: max(today(), season+"-01-01"),
+				EndDate:   season + "-12-31",
+				GameTypes: []string{"R"},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get remaining schedule: %w", err)
+			}
+			games = simulate.GamesFromSchedule(schedule)
+		}
+
+		odds, err := simulate.Run(ctx, teams, games, simulate.Options{
+			Iterations: simIterationsFlag,
+			Seed:       seed,
+		})
+		if err != nil {
+			return fmt.Errorf("simulation failed: %w", err)
+		}
+
+		return GetFormatter().PrintOdds(odds, season, len(games), simIterationsFlag, seed)
+	},
+}
+
+func init() {
+	// Add subcommands to 'simulate'
+	simulateCmd.AddCommand(simSeasonCmd)
+
+	// Flags for season
+	simSeasonCmd.Flags().StringVarP(&simSeasonFlag, "season", "s", "",
+		"Season year (default: current year)")
+	simSeasonCmd.Flags().IntVarP(&simIterationsFlag, "iterations", "n", 10000,
+		"Number of seasons to simulate")
+	simSeasonCmd.Flags().Uint64Var(&simSeedFlag, "seed", 0,
+		"Random seed for reproducible results (default: random)")
+}
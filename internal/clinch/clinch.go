@@ -0,0 +1,176 @@
+// Package clinch computes magic and elimination numbers from standings.
+//
+// A magic number is the combination of wins by a team and losses by its
+// nearest pursuer that clinches a spot; an elimination (or tragic) number
+// is the same count seen from the trailing team. Both come from the games
+// each team has left on its schedule, so shortened seasons and rainouts
+// that are never made up are counted correctly.
+package clinch
+
+import (
+	"context"
+	"time"
+
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// Status holds a team's clinching numbers. A nil number does not apply to
+// the team, e.g. a division leader has no division elimination number.
+type Status struct {
+	TeamID int    `json:"teamId"`
+	Team   string `json:"team"`
+
+	DivisionMagic       *int `json:"divisionMagic,omitempty"`
+	DivisionElimination *int `json:"divisionElimination,omitempty"`
+	WildCardMagic       *int `json:"wildCardMagic,omitempty"`
+	WildCardElimination *int `json:"wildCardElimination,omitempty"`
+
+	ClinchedDivision   bool `json:"clinchedDivision"`
+	ClinchedWildCard   bool `json:"clinchedWildCard"`
+	EliminatedDivision bool `json:"eliminatedDivision"`
+	EliminatedWildCard bool `json:"eliminatedWildCard"`
+}
+
+// Eliminated reports whether the team can no longer reach the postseason.
+// Without wild card numbers only the division is considered.
+func (s Status) Eliminated() bool {
+	if s.WildCardMagic == nil && s.WildCardElimination == nil {
+		return s.EliminatedDivision
+	}
+	return s.EliminatedDivision && s.EliminatedWildCard
+}
+
+// Compute returns the status of every team in the division standings,
+// keyed by team ID. remaining holds the games each team has left to play,
+// as returned by GamesRemaining; a team missing from it has none. Wild
+// card numbers are filled in from the wild card standings when they are
+// given; wildCard may be nil.
+func Compute(division, wildCard *models.StandingsResponse, remaining map[int]int, wildCardSpots int) map[int]*Status {
+	statuses := make(map[int]*Status)
+	status := func(tr models.TeamRecord) *Status {
+		s, ok := statuses[tr.Team.ID]
+		if !ok {
+			s = &Status{TeamID: tr.Team.ID, Team: tr.Team.Name}
+			statuses[tr.Team.ID] = s
+		}
+		return s
+	}
+
+	for _, rec := range division.Records {
+		teams := rec.TeamRecords
+		if len(teams) == 0 {
+			continue
+		}
+		leader := teams[0]
+
+		magic := magicNumber(leader, teams[1:], remaining)
+		s := status(leader)
+		s.DivisionMagic = &magic
+		s.ClinchedDivision = len(teams) > 1 && magic == 0
+
+		for _, tr := range teams[1:] {
+			elim := number(leader, tr, remaining)
+			s := status(tr)
+			s.DivisionElimination = &elim
+			s.EliminatedDivision = elim == 0
+		}
+	}
+
+	if wildCard == nil {
+		return statuses
+	}
+
+	for _, rec := range wildCard.Records {
+		teams := rec.TeamRecords
+		if len(teams) <= wildCardSpots {
+			continue
+		}
+		lastIn, out := teams[wildCardSpots-1], teams[wildCardSpots:]
+
+		for _, tr := range teams[:wildCardSpots] {
+			magic := magicNumber(tr, out, remaining)
+			s := status(tr)
+			s.WildCardMagic = &magic
+			s.ClinchedWildCard = magic == 0
+		}
+		for _, tr := range out {
+			elim := number(lastIn, tr, remaining)
+			s := status(tr)
+			s.WildCardElimination = &elim
+			s.EliminatedWildCard = elim == 0
+		}
+	}
+
+	return statuses
+}
+
+// magicNumber returns the number that clinches a finish ahead of every
+// pursuer, which is set by the pursuer that can still win the most games
+func magicNumber(team models.TeamRecord, pursuers []models.TeamRecord, remaining map[int]int) int {
+	magic := 0
+	for _, p := range pursuers {
+		magic = max(magic, number(team, p, remaining))
+	}
+	return magic
+}
+
+// number returns ahead's magic number against behind, which is also
+// behind's elimination number against ahead: the wins by ahead and losses
+// by behind that take ahead past the most games behind can still win
+func number(ahead, behind models.TeamRecord, remaining map[int]int) int {
+	return max(0, behind.Wins+remaining[behind.Team.ID]+1-ahead.Wins)
+}
+
+// GamesRemaining fetches the regular season schedule after the standings
+// selected by query and counts the games each team has left, by team ID.
+// Standings without a date are counted from today. A finished season has
+// no games left and makes no request.
+func GamesRemaining(ctx context.Context, src api.DataSource, query api.StandingsQuery, today string) (map[int]int, error) {
+	asOf := query.Date
+	if asOf == "" {
+		asOf = today
+	}
+	if _, err := time.Parse("2006-01-02", asOf); err != nil {
+		return nil, api.InvalidInput("invalid date: %s (use YYYY-MM-DD)", asOf)
+	}
+
+	schedule := api.ScheduleQuery{
+		StartDate: max(asOf, query.Season+"-01-01"),
+		EndDate:   query.Season + "-12-31",
+		GameTypes: []string{"R"},
+	}
+	if schedule.StartDate > schedule.EndDate {
+		return map[int]int{}, nil
+	}
+
+	resp, err := src.GetSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+	return Remaining(resp, asOf), nil
+}
+
+// Remaining counts the games each team has left in a schedule after the
+// standings of asOf, by team ID. Games on asOf that are already over are
+// in the standings, and postponed or cancelled games are skipped since
+// makeups appear separately. A suspended game listed on both its original
+// and resumption dates counts once.
+func Remaining(schedule *models.ScheduleResponse, asOf string) map[int]int {
+	remaining := make(map[int]int)
+	seen := make(map[int]bool)
+	for _, d := range schedule.Dates {
+		if d.Date < asOf {
+			continue
+		}
+		for _, g := range d.Games {
+			if g.IsCalledOff() || (d.Date == asOf && g.IsOver()) || seen[g.GamePk] {
+				continue
+			}
+			seen[g.GamePk] = true
+			remaining[g.Teams.Away.Team.ID]++
+			remaining[g.Teams.Home.Team.ID]++
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,183 @@
+package clinch
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// team returns a standings line for a team
+func team(id, wins, losses int) models.TeamRecord {
+	var tr models.TeamRecord
+	tr.Team.ID = id
+	tr.Wins, tr.Losses = wins, losses
+	return tr
+}
+
+// standings returns one division or league of standings, in order
+func standings(teams ...models.TeamRecord) *models.StandingsResponse {
+	return &models.StandingsResponse{Records: []models.StandingsRecord{{TeamRecords: teams}}}
+}
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		name          string
+		ahead, behind models.TeamRecord
+		remaining     map[int]int
+		want          int
+	}{
+		{"full season race", team(1, 90, 65), team(2, 85, 70), map[int]int{1: 7, 2: 7}, 3},
+		{"clinched", team(1, 100, 55), team(2, 85, 70), map[int]int{1: 7, 2: 7}, 0},
+		{"short season", team(1, 35, 20), team(2, 30, 25), map[int]int{1: 5, 2: 5}, 1},
+		{"rainout never made up", team(1, 90, 71), team(2, 89, 72), map[int]int{1: 1}, 0},
+		{"season over", team(1, 92, 70), team(2, 92, 70), nil, 1},
+		{"opening day", team(1, 0, 0), team(2, 0, 0), map[int]int{1: 162, 2: 162}, 163},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := number(tt.ahead, tt.behind, tt.remaining); got != tt.want {
+				t.Errorf("number() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name      string
+		division  *models.StandingsResponse
+		wildCard  *models.StandingsResponse
+		remaining map[int]int
+		want      map[int]Status
+	}{
+		{
+			name:      "race",
+			division:  standings(team(1, 90, 65), team(2, 85, 70), team(3, 80, 75)),
+			remaining: map[int]int{1: 7, 2: 7, 3: 7},
+			want: map[int]Status{
+				1: {DivisionMagic: ptr(3)},
+				2: {DivisionElimination: ptr(3)},
+				3: {DivisionElimination: ptr(0), EliminatedDivision: true},
+			},
+		},
+		{
+			name:      "clinched and eliminated",
+			division:  standings(team(1, 100, 55), team(2, 85, 70)),
+			remaining: map[int]int{1: 7, 2: 7},
+			want: map[int]Status{
+				1: {DivisionMagic: ptr(0), ClinchedDivision: true},
+				2: {DivisionElimination: ptr(0), EliminatedDivision: true},
+			},
+		},
+		{
+			name:      "short season",
+			division:  standings(team(1, 35, 20), team(2, 30, 25)),
+			remaining: map[int]int{1: 5, 2: 5},
+			want: map[int]Status{
+				1: {DivisionMagic: ptr(1)},
+				2: {DivisionElimination: ptr(1)},
+			},
+		},
+		{
+			name:      "wild card",
+			division:  standings(team(1, 95, 60), team(2, 90, 65), team(3, 84, 71)),
+			wildCard:  standings(team(2, 90, 65), team(3, 84, 71), team(4, 80, 75)),
+			remaining: map[int]int{1: 7, 2: 7, 3: 7, 4: 7},
+			want: map[int]Status{
+				1: {DivisionMagic: ptr(3)},
+				2: {DivisionElimination: ptr(3), WildCardMagic: ptr(0), ClinchedWildCard: true},
+				3: {DivisionElimination: ptr(0), EliminatedDivision: true, WildCardMagic: ptr(4)},
+				4: {WildCardElimination: ptr(4)},
+			},
+		},
+		{
+			name:     "lone team",
+			division: standings(team(1, 10, 5)),
+			want: map[int]Status{
+				1: {DivisionMagic: ptr(0)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compute(tt.division, tt.wildCard, tt.remaining, 2)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Compute() returned %d teams, want %d", len(got), len(tt.want))
+			}
+			for id, want := range tt.want {
+				s, ok := got[id]
+				if !ok {
+					t.Fatalf("team %d missing", id)
+				}
+				want.TeamID = id
+				if !reflect.DeepEqual(*s, want) {
+					t.Errorf("team %d = %s, want %s", id, format(*s), format(want))
+				}
+			}
+		})
+	}
+}
+
+func TestGamesRemaining(t *testing.T) {
+	src, err := api.LoadFixtures(os.DirFS("testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		query api.StandingsQuery
+		today string
+		want  map[int]int
+	}{
+		// Final and postponed games today are skipped, and the suspended
+		// game counts once across its two dates
+		{"current", api.SeasonStandings("2024"), "2024-09-28", map[int]int{1: 2, 2: 2, 3: 2, 4: 2}},
+		// Games after an earlier date count even once they are final
+		{"as of date", api.StandingsQuery{Season: "2024", Date: "2024-09-27"}, "2024-10-15", map[int]int{1: 3, 2: 3, 3: 2, 4: 2}},
+		{"last day", api.SeasonStandings("2024"), "2024-09-29", map[int]int{1: 2, 2: 2, 3: 1, 4: 1}},
+		// There is no fixture for 2023, so this fails unless no request is made
+		{"past season", api.SeasonStandings("2023"), "2024-09-28", map[int]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GamesRemaining(context.Background(), src, tt.query, tt.today)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("GamesRemaining() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := GamesRemaining(context.Background(), src, api.SeasonStandings("2024"), "yesterday"); api.KindOf(err) != api.KindInvalidInput {
+		t.Errorf("GamesRemaining(bad date) error = %v, want invalid input", err)
+	}
+}
+
+func ptr(n int) *int {
+	return &n
+}
+
+// format renders a status with its numbers dereferenced
+func format(s Status) string {
+	n := func(p *int) any {
+		if p == nil {
+			return "nil"
+		}
+		return *p
+	}
+	return fmt.Sprintf("{divMagic:%v divElim:%v wcMagic:%v wcElim:%v clinched:%v/%v eliminated:%v/%v}",
+		n(s.DivisionMagic), n(s.DivisionElimination), n(s.WildCardMagic), n(s.WildCardElimination),
+		s.ClinchedDivision, s.ClinchedWildCard, s.EliminatedDivision, s.EliminatedWildCard)
+}
@@ -0,0 +1,185 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/sgracia13/mlb-cli/internal/sabermetrics"
+	"github.com/sgracia13/mlb-cli/pkg/api"
+)
+
+// Environment variables that override the config file
+const (
+	EnvConfig  = "MLB_CONFIG"  // path to the config file
+	EnvProfile = "MLB_PROFILE" // profile to use
+)
+
+// Config is the contents of the mlb config file
+type Config struct {
+	CurrentProfile string             `yaml:"currentProfile"`
+	Timezone       string             `yaml:"timezone"` // IANA name, e.g. America/Los_Angeles
+	Profiles       map[string]Profile `yaml:"profiles"`
+
+	// Per-season wOBA weights and FIP constants, overriding the defaults
+	Sabermetrics sabermetrics.Table `yaml:"sabermetrics"`
+}
+
+// Profile holds the connection settings for one way of reaching the API
+type Profile struct {
+	BaseURL   string            `yaml:"baseURL"`   // e.g. a local mirror
+	Proxy     string            `yaml:"proxy"`     // HTTP(S) proxy URL
+	CAFile    string            `yaml:"caFile"`    // PEM bundle trusted in addition to the system roots
+	Timeout   time.Duration     `yaml:"timeout"`   // per-request timeout, e.g. 30s
+	UserAgent string            `yaml:"userAgent"` // replaces the default User-Agent
+	Headers   map[string]string `yaml:"headers"`   // sent with every request
+}
+
+// DefaultPath returns the config file location: $MLB_CONFIG, or
+// config.yaml under the user config dir
+func DefaultPath() (string, error) {
+	if path := os.Getenv(EnvConfig); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "mlb-cli", "config.yaml"), nil
+}
+
+// Load reads the config file at path. A missing file is an empty config.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Profile returns the named profile. An empty name falls back to
+// $MLB_PROFILE, then to currentProfile; if none is set the zero Profile,
+// which uses the built-in defaults, is returned.
+func (c *Config) Profile(name string) (Profile, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	if name == "" {
+		name = c.CurrentProfile
+	}
+	if name == "" {
+		return Profile{}, nil
+	}
+
+	if p, ok := c.Profiles[name]; ok {
+		return p, nil
+	}
+
+	names := make([]string, 0, len(c.Profiles))
+	for n := range c.Profiles {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return Profile{}, api.InvalidInput("unknown profile %q (available: %s)", name, strings.Join(names, ", "))
+}
+
+// Location returns the time zone for game times and dates. An empty name
+// falls back to the timezone setting, then to the system's local zone.
+func (c *Config) Location(name string) (*time.Location, error) {
+	if name == "" {
+		name = c.Timezone
+	}
+	if name == "" || strings.EqualFold(name, "local") {
+		return time.Local, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, api.InvalidInput("unknown time zone %q (use an IANA name like America/New_York)", name)
+	}
+	return loc, nil
+}
+
+// SabermetricsTable returns the wOBA and FIP constants: the defaults with any
+// seasons from the config file replacing or adding to them
+func (c *Config) SabermetricsTable() sabermetrics.Table {
+	return sabermetrics.DefaultConstants.Merge(c.Sabermetrics)
+}
+
+// ClientOptions converts the profile into API client options
+func (p Profile) ClientOptions() ([]api.Option, error) {
+	var opts []api.Option
+
+	if p.BaseURL != "" {
+		opts = append(opts, api.WithBaseURL(p.BaseURL))
+	}
+	if p.UserAgent != "" {
+		opts = append(opts, api.WithUserAgent(p.UserAgent))
+	}
+	if len(p.Headers) > 0 {
+		opts = append(opts, api.WithHeaders(p.Headers))
+	}
+
+	if p.Proxy != "" || p.CAFile != "" || p.Timeout > 0 {
+		httpClient, err := p.httpClient()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, api.WithHTTPClient(httpClient))
+	}
+
+	return opts, nil
+}
+
+// httpClient builds an HTTP client honoring the proxy, CA and timeout settings
+func (p Profile) httpClient() (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if p.Proxy != "" {
+		proxyURL, err := url.Parse(p.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy %q: %w", p.Proxy, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if p.CAFile != "" {
+		pem, err := os.ReadFile(p.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", p.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = api.DefaultTimeout
+	}
+	return &http.Client{Transport: transport, Timeout: timeout}, nil
+}
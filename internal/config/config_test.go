@@ -0,0 +1,241 @@
+package config
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sgracia13/mlb-cli/pkg/api"
+)
+
+// load writes yaml to a temp config file and loads it
+func load(t *testing.T, yaml string) *Config {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+const profilesYAML = `
+currentProfile: mirror
+timezone: Asia/Tokyo
+profiles:
+  mirror:
+    baseURL: http://mirror.local/api/v1
+  corp:
+    proxy: http://proxy.corp:3128
+    timeout: 30s
+`
+
+func TestLoad(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err != nil || cfg.CurrentProfile != "" || len(cfg.Profiles) != 0 {
+		t.Errorf("Load(missing) = %+v, %v, want an empty config", cfg, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("profiles: [oops"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Load(malformed) returned no error")
+	}
+
+	cfg = load(t, profilesYAML)
+	if got := cfg.Profiles["corp"].Timeout; got != 30*time.Second {
+		t.Errorf("corp timeout = %v, want 30s", got)
+	}
+}
+
+func TestProfile(t *testing.T) {
+	cfg := load(t, profilesYAML)
+
+	tests := []struct {
+		name    string
+		flag    string
+		env     string
+		config  *Config
+		want    string // base URL of the chosen profile
+		wantErr bool
+	}{
+		{"current profile", "", "", cfg, "http://mirror.local/api/v1", false},
+		{"env beats current profile", "", "corp", cfg, "", false},
+		{"flag beats env", "mirror", "corp", cfg, "http://mirror.local/api/v1", false},
+		{"unknown flag", "staging", "", cfg, "", true},
+		{"unknown env", "", "staging", cfg, "", true},
+		{"nothing set", "", "", &Config{}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvProfile, tt.env)
+			p, err := tt.config.Profile(tt.flag)
+			if tt.wantErr {
+				if api.KindOf(err) != api.KindInvalidInput {
+					t.Errorf("Profile(%q) error = %v, want invalid input", tt.flag, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.BaseURL != tt.want {
+				t.Errorf("Profile(%q).BaseURL = %q, want %q", tt.flag, p.BaseURL, tt.want)
+			}
+		})
+	}
+
+	t.Setenv(EnvProfile, "")
+	_, err := cfg.Profile("staging")
+	if want := `unknown profile "staging" (available: corp, mirror)`; err == nil || err.Error() != want {
+		t.Errorf("Profile(unknown) error = %v, want %q", err, want)
+	}
+}
+
+func TestLocation(t *testing.T) {
+	tokyo := load(t, profilesYAML)
+
+	tests := []struct {
+		name    string
+		flag    string
+		config  *Config
+		want    string
+		wantErr bool
+	}{
+		{"config timezone", "", tokyo, "Asia/Tokyo", false},
+		{"flag beats config", "America/New_York", tokyo, "America/New_York", false},
+		{"local", "local", tokyo, time.Local.String(), false},
+		{"nothing set", "", &Config{}, time.Local.String(), false},
+		{"unknown", "Mars/Olympus_Mons", tokyo, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := tt.config.Location(tt.flag)
+			if tt.wantErr {
+				if api.KindOf(err) != api.KindInvalidInput {
+					t.Errorf("Location(%q) error = %v, want invalid input", tt.flag, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if loc.String() != tt.want {
+				t.Errorf("Location(%q) = %s, want %s", tt.flag, loc, tt.want)
+			}
+		})
+	}
+}
+
+// teams serves an empty teams list and records the last request
+func teams(last **http.Request) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*last = r
+		w.Write([]byte(`{"teams": []}`))
+	}
+}
+
+// getTeams calls the API with the profile's options and no retries
+func getTeams(t *testing.T, p Profile) error {
+	t.Helper()
+	opts, err := p.ClientOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := api.NewClient(append(opts, api.WithRetry(api.RetryPolicy{}))...)
+	_, err = client.GetTeams(context.Background())
+	return err
+}
+
+func TestClientOptions(t *testing.T) {
+	t.Run("base URL, user agent and headers", func(t *testing.T) {
+		var req *http.Request
+		srv := httptest.NewServer(teams(&req))
+		defer srv.Close()
+
+		err := getTeams(t, Profile{
+			BaseURL:   srv.URL + "/mirror/v1",
+			UserAgent: "scout/1.0",
+			Headers:   map[string]string{"X-Api-Key": "secret"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if req.URL.Path != "/mirror/v1/teams" || req.UserAgent() != "scout/1.0" || req.Header.Get("X-Api-Key") != "secret" {
+			t.Errorf("request = %s UA %q key %q", req.URL.Path, req.UserAgent(), req.Header.Get("X-Api-Key"))
+		}
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		var req *http.Request
+		proxy := httptest.NewServer(teams(&req))
+		defer proxy.Close()
+
+		if err := getTeams(t, Profile{BaseURL: "http://statsapi.invalid/api/v1", Proxy: proxy.URL}); err != nil {
+			t.Fatal(err)
+		}
+		if req.Host != "statsapi.invalid" {
+			t.Errorf("proxy got a request for %q, want statsapi.invalid", req.Host)
+		}
+	})
+
+	t.Run("CA file", func(t *testing.T) {
+		var req *http.Request
+		srv := httptest.NewTLSServer(teams(&req))
+		defer srv.Close()
+
+		if err := getTeams(t, Profile{BaseURL: srv.URL}); api.KindOf(err) != api.KindNetwork {
+			t.Fatalf("untrusted server: error = %v, want a network error", err)
+		}
+
+		ca := filepath.Join(t.TempDir(), "ca.pem")
+		block := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+		if err := os.WriteFile(ca, block, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := getTeams(t, Profile{BaseURL: srv.URL, CAFile: ca}); err != nil {
+			t.Errorf("trusted server: %v", err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		defer srv.Close()
+
+		if err := getTeams(t, Profile{BaseURL: srv.URL, Timeout: 20 * time.Millisecond}); api.KindOf(err) != api.KindNetwork {
+			t.Errorf("slow server: error = %v, want a network error", err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		missing := filepath.Join(t.TempDir(), "missing.pem")
+		notPEM := filepath.Join(t.TempDir(), "ca.pem")
+		if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, p := range []Profile{
+			{Proxy: "http://bad proxy"},
+			{CAFile: missing},
+			{CAFile: notPEM},
+		} {
+			if _, err := p.ClientOptions(); err == nil {
+				t.Errorf("ClientOptions(%+v) returned no error", p)
+			}
+		}
+	})
+}
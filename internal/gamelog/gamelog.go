@@ -0,0 +1,243 @@
+// Package gamelog filters a player's game log to a date range and adds up
+// its lines over rolling windows, e.g. the last 7, 15 and 30 days, to show
+// who is hot right now.
+package gamelog
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// DefaultWindows are the rolling windows, in days, shown by default
+var DefaultWindows = []int{7, 15, 30}
+
+// Log is a player's game log for one season
+type Log struct {
+	PlayerID int    `json:"playerId"`
+	Player   string `json:"player"`
+	Season   string `json:"season"`
+	Start    string `json:"start,omitempty"`
+	End      string `json:"end,omitempty"`
+	AsOf     string `json:"asOf,omitempty"` // last day of the rolling windows
+
+	Hitting  *Section `json:"hitting,omitempty"`
+	Pitching *Section `json:"pitching,omitempty"`
+}
+
+// Section holds one stat group's games, oldest first, and their totals
+type Section struct {
+	Games   []models.StatSplit `json:"games"`
+	Total   Window             `json:"total"`
+	Windows []Window           `json:"windows"`
+}
+
+// Window is the combined line over a span of days. Rate stats are
+// recomputed from the summed counting stats.
+type Window struct {
+	Days     int                   `json:"days,omitempty"` // 0 for the whole range
+	Games    int                   `json:"games"`
+	Hitting  *models.HittingStats  `json:"hitting,omitempty"`
+	Pitching *models.PitchingStats `json:"pitching,omitempty"`
+}
+
+// Build filters a game log to games from start to end, either of which may
+// be empty, and totals the windows ending on asOf. An empty asOf uses the
+// date of the last game.
+func Build(resp *models.StatsResponse, season, start, end, asOf string, windows []int) *Log {
+	log := &Log{Season: season, Start: start, End: end}
+
+	for _, group := range resp.Stats {
+		games := filter(group.Splits, start, end)
+		if len(games) == 0 {
+			continue
+		}
+		if p := games[0].Player; p != nil && log.Player == "" {
+			log.PlayerID = p.ID
+			log.Player = p.FullName
+		}
+		if last := games[len(games)-1].Date; last > log.AsOf && asOf == "" {
+			log.AsOf = last
+		}
+
+		switch group.Group.DisplayName {
+		case models.GroupHitting:
+			log.Hitting = &Section{Games: games}
+		case models.GroupPitching:
+			log.Pitching = &Section{Games: games}
+		}
+	}
+	if asOf != "" {
+		log.AsOf = asOf
+	}
+
+	for _, s := range []*Section{log.Hitting, log.Pitching} {
+		if s == nil {
+			continue
+		}
+		s.Total = total(s.Games)
+		for _, days := range windows {
+			w := total(since(s.Games, log.AsOf, days))
+			w.Days = days
+			s.Windows = append(s.Windows, w)
+		}
+	}
+
+	return log
+}
+
+// filter returns the splits dated from start to end
+func filter(splits []models.StatSplit, start, end string) []models.StatSplit {
+	var games []models.StatSplit
+	for _, s := range splits {
+		if start != "" && s.Date < start {
+			continue
+		}
+		if end != "" && s.Date > end {
+			continue
+		}
+		games = append(games, s)
+	}
+	return games
+}
+
+// since returns the games in the days days ending on asOf
+func since(games []models.StatSplit, asOf string, days int) []models.StatSplit {
+	last, err := time.Parse("2006-01-02", asOf)
+	if err != nil {
+		return nil
+	}
+	first := last.AddDate(0, 0, 1-days).Format("2006-01-02")
+	return filter(games, first, asOf)
+}
+
+// total adds up the lines of the games
+func total(games []models.StatSplit) Window {
+	w := Window{Games: len(games)}
+	for _, g := range games {
+		if g.Hitting != nil {
+			if w.Hitting == nil {
+				w.Hitting = &models.HittingStats{}
+			}
+			addHitting(w.Hitting, g.Hitting)
+		}
+		if g.Pitching != nil {
+			if w.Pitching == nil {
+				w.Pitching = &models.PitchingStats{}
+			}
+			addPitching(w.Pitching, g.Pitching)
+		}
+	}
+	if w.Hitting != nil {
+		hittingRates(w.Hitting)
+	}
+	if w.Pitching != nil {
+		pitchingRates(w.Pitching)
+	}
+	return w
+}
+
+// addHitting adds the counting stats of b to a
+func addHitting(a, b *models.HittingStats) {
+	a.GamesPlayed += b.GamesPlayed
+	a.PlateAppearances += b.PlateAppearances
+	a.AtBats += b.AtBats
+	a.Runs += b.Runs
+	a.Hits += b.Hits
+	a.Doubles += b.Doubles
+	a.Triples += b.Triples
+	a.HomeRuns += b.HomeRuns
+	a.RBI += b.RBI
+	a.TotalBases += b.TotalBases
+	a.BaseOnBalls += b.BaseOnBalls
+	a.IntentionalWalks += b.IntentionalWalks
+	a.HitByPitch += b.HitByPitch
+	a.StrikeOuts += b.StrikeOuts
+	a.SacFlies += b.SacFlies
+	a.SacBunts += b.SacBunts
+	a.StolenBases += b.StolenBases
+	a.CaughtStealing += b.CaughtStealing
+	a.GroundIntoDoublePlay += b.GroundIntoDoublePlay
+	a.LeftOnBase += b.LeftOnBase
+}
+
+// hittingRates fills in the rate stats from the counting stats
+func hittingRates(h *models.HittingStats) {
+	obp := h.Hits + h.BaseOnBalls + h.HitByPitch
+	obpDenom := h.AtBats + h.BaseOnBalls + h.HitByPitch + h.SacFlies
+
+	h.Avg = average(h.Hits, h.AtBats)
+	h.OBP = average(obp, obpDenom)
+	h.SLG = average(h.TotalBases, h.AtBats)
+	if h.AtBats > 0 && obpDenom > 0 {
+		ops := float64(obp)/float64(obpDenom) + float64(h.TotalBases)/float64(h.AtBats)
+		h.OPS = trimZero(strconv.FormatFloat(ops, 'f', 3, 64))
+	}
+	h.BABIP = average(h.Hits-h.HomeRuns, h.AtBats-h.StrikeOuts-h.HomeRuns+h.SacFlies)
+}
+
+// addPitching adds the counting stats of b to a
+func addPitching(a, b *models.PitchingStats) {
+	a.GamesPlayed += b.GamesPlayed
+	a.GamesStarted += b.GamesStarted
+	a.Wins += b.Wins
+	a.Losses += b.Losses
+	a.Saves += b.Saves
+	a.SaveOpportunities += b.SaveOpportunities
+	a.Holds += b.Holds
+	a.BlownSaves += b.BlownSaves
+	a.CompleteGames += b.CompleteGames
+	a.Shutouts += b.Shutouts
+	a.Outs += b.TotalOuts()
+	a.BattersFaced += b.BattersFaced
+	a.Hits += b.Hits
+	a.Runs += b.Runs
+	a.EarnedRuns += b.EarnedRuns
+	a.HomeRuns += b.HomeRuns
+	a.BaseOnBalls += b.BaseOnBalls
+	a.IntentionalWalks += b.IntentionalWalks
+	a.HitByPitch += b.HitByPitch
+	a.StrikeOuts += b.StrikeOuts
+	a.NumberOfPitches += b.NumberOfPitches
+}
+
+// pitchingRates fills in innings pitched and the rate stats from the
+// counting stats
+func pitchingRates(p *models.PitchingStats) {
+	p.InningsPitched = strconv.Itoa(p.Outs/3) + "." + strconv.Itoa(p.Outs%3)
+	p.ERA = perNine(p.EarnedRuns, p.Outs)
+	p.StrikeoutsPer9Inn = perNine(p.StrikeOuts, p.Outs)
+	p.WalksPer9Inn = perNine(p.BaseOnBalls, p.Outs)
+	p.HitsPer9Inn = perNine(p.Hits, p.Outs)
+	if p.Outs > 0 {
+		whip := float64(p.BaseOnBalls+p.Hits) * 3 / float64(p.Outs)
+		p.WHIP = strconv.FormatFloat(whip, 'f', 2, 64)
+	}
+	if p.BaseOnBalls > 0 {
+		p.StrikeoutWalkRatio = strconv.FormatFloat(float64(p.StrikeOuts)/float64(p.BaseOnBalls), 'f', 2, 64)
+	}
+}
+
+// average formats n/d the way the API writes averages, e.g. ".300", or ""
+// when d is not positive
+func average(n, d int) string {
+	if d <= 0 {
+		return ""
+	}
+	return trimZero(strconv.FormatFloat(float64(n)/float64(d), 'f', 3, 64))
+}
+
+// perNine formats a count per nine innings, e.g. an ERA, or "" without outs
+func perNine(n, outs int) string {
+	if outs <= 0 {
+		return ""
+	}
+	return strconv.FormatFloat(float64(n)*27/float64(outs), 'f', 2, 64)
+}
+
+// trimZero drops the leading zero of a rate below one
+func trimZero(s string) string {
+	return strings.TrimPrefix(s, "0")
+}
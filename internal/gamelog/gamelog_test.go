@@ -0,0 +1,181 @@
+package gamelog
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// load returns the fixture game log of a player who hits and pitches
+func load(t *testing.T) *models.StatsResponse {
+	t.Helper()
+	src, err := api.LoadFixtures(os.DirFS("testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := src.GetGameLog(context.Background(), "660271", "2024")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+// windowGames returns the number of games in each window
+func windowGames(s *Section) []int {
+	var games []int
+	for _, w := range s.Windows {
+		games = append(games, w.Games)
+	}
+	return games
+}
+
+func TestBuild(t *testing.T) {
+	resp := load(t)
+
+	tests := []struct {
+		name             string
+		start, end, asOf string
+		wantAsOf         string
+		hitting          []int // games in the total, then in each window
+		pitching         []int
+	}{
+		{"whole season", "", "", "", "2024-06-30", []int{5, 2, 3, 5}, []int{3, 1, 2, 3}},
+		{"date range", "2024-06-10", "2024-06-25", "", "2024-06-25", []int{3, 2, 2, 3}, []int{1, 0, 1, 1}},
+		{"as of", "", "", "2024-06-12", "2024-06-12", []int{5, 1, 2, 2}, []int{3, 0, 1, 1}},
+		{"no pitching in range", "2024-06-30", "", "", "2024-06-30", []int{1, 1, 1, 1}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := Build(resp, "2024", tt.start, tt.end, tt.asOf, DefaultWindows)
+			if log.PlayerID != 660271 || log.Player != "Shohei Ohtani" {
+				t.Errorf("player = %d %q, want 660271 Shohei Ohtani", log.PlayerID, log.Player)
+			}
+			if log.AsOf != tt.wantAsOf {
+				t.Errorf("AsOf = %q, want %q", log.AsOf, tt.wantAsOf)
+			}
+
+			for _, s := range []struct {
+				name    string
+				section *Section
+				want    []int
+			}{
+				{"hitting", log.Hitting, tt.hitting},
+				{"pitching", log.Pitching, tt.pitching},
+			} {
+				if s.want == nil {
+					if s.section != nil {
+						t.Errorf("%s = %d games, want none", s.name, len(s.section.Games))
+					}
+					continue
+				}
+				if s.section == nil {
+					t.Fatalf("%s missing", s.name)
+				}
+				got := append([]int{s.section.Total.Games}, windowGames(s.section)...)
+				if !reflect.DeepEqual(got, s.want) {
+					t.Errorf("%s games = %v, want %v", s.name, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSince(t *testing.T) {
+	games := load(t).Stats[0].Splits
+
+	tests := []struct {
+		asOf string
+		days int
+		want []string
+	}{
+		{"2024-06-30", 1, []string{"2024-06-30"}},
+		{"2024-06-30", 6, []string{"2024-06-25", "2024-06-30"}},
+		{"2024-06-30", 30, []string{"2024-06-01", "2024-06-10", "2024-06-20", "2024-06-25", "2024-06-30"}},
+		{"2024-06-09", 7, nil},
+		{"today", 7, nil},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, g := range since(games, tt.asOf, tt.days) {
+			got = append(got, g.Date)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("since(%s, %d) = %v, want %v", tt.asOf, tt.days, got, tt.want)
+		}
+	}
+}
+
+func TestTotal(t *testing.T) {
+	resp := load(t)
+	hitting, pitching := resp.Stats[0].Splits, resp.Stats[1].Splits
+
+	t.Run("hitting", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			games []models.StatSplit
+			want  models.HittingStats
+		}{
+			{"season", hitting, models.HittingStats{
+				GamesPlayed: 5, PlateAppearances: 22, AtBats: 20, Hits: 7, Doubles: 1, HomeRuns: 2,
+				TotalBases: 14, BaseOnBalls: 2, StrikeOuts: 5,
+				Avg: ".350", OBP: ".409", SLG: ".700", OPS: "1.109", BABIP: ".385",
+			}},
+			{"hitless", hitting[2:3], models.HittingStats{
+				GamesPlayed: 1, PlateAppearances: 4, AtBats: 4, StrikeOuts: 2,
+				Avg: ".000", OBP: ".000", SLG: ".000", OPS: ".000", BABIP: ".000",
+			}},
+		}
+
+		for _, tt := range tests {
+			w := total(tt.games)
+			if w.Pitching != nil {
+				t.Errorf("%s: unexpected pitching line", tt.name)
+			}
+			if w.Hitting == nil || !reflect.DeepEqual(*w.Hitting, tt.want) {
+				t.Errorf("%s: total() = %+v, want %+v", tt.name, w.Hitting, tt.want)
+			}
+		}
+	})
+
+	t.Run("pitching", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			games []models.StatSplit
+			want  models.PitchingStats
+		}{
+			// 6.0 + 5.1 + 7.0 innings is 55 outs
+			{"season", pitching, models.PitchingStats{
+				GamesPlayed: 3, GamesStarted: 3, Wins: 2, Losses: 1, Outs: 55,
+				Hits: 14, EarnedRuns: 5, BaseOnBalls: 3, StrikeOuts: 22,
+				InningsPitched: "18.1", ERA: "2.45", WHIP: "0.93", StrikeoutWalkRatio: "7.33",
+				StrikeoutsPer9Inn: "10.80", WalksPer9Inn: "1.47", HitsPer9Inn: "6.87",
+			}},
+			// No walks leaves K/BB empty rather than infinite
+			{"no walks", pitching[2:], models.PitchingStats{
+				GamesPlayed: 1, GamesStarted: 1, Wins: 1, Outs: 21, Hits: 3, StrikeOuts: 9,
+				InningsPitched: "7.0", ERA: "0.00", WHIP: "0.43",
+				StrikeoutsPer9Inn: "11.57", WalksPer9Inn: "0.00", HitsPer9Inn: "3.86",
+			}},
+		}
+
+		for _, tt := range tests {
+			w := total(tt.games)
+			if w.Hitting != nil {
+				t.Errorf("%s: unexpected hitting line", tt.name)
+			}
+			if w.Pitching == nil || !reflect.DeepEqual(*w.Pitching, tt.want) {
+				t.Errorf("%s: total() = %+v, want %+v", tt.name, w.Pitching, tt.want)
+			}
+		}
+	})
+
+	if w := total(nil); w.Games != 0 || w.Hitting != nil || w.Pitching != nil {
+		t.Errorf("total(nil) = %+v, want empty", w)
+	}
+}
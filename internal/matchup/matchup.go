@@ -0,0 +1,116 @@
+// Package matchup collects batter-vs-pitcher history: one batter against
+// one pitcher, or a team's lineup against the opposing probable starter.
+package matchup
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// Workers is the number of matchups looked up at once for a lineup
+const Workers = 4
+
+// Line is a batter's history against a pitcher
+type Line struct {
+	Batter  models.PersonRef     `json:"batter"`
+	Pitcher models.PersonRef     `json:"pitcher"`
+	Career  *models.HittingStats `json:"career,omitempty"` // nil if they never faced each other
+	Seasons []models.StatSplit   `json:"seasons,omitempty"`
+}
+
+// Side is a team's lineup against the opposing probable starter
+type Side struct {
+	Team    models.GameTeam   `json:"team"`
+	Pitcher *models.PersonRef `json:"pitcher"` // nil until a starter is announced
+	Lines   []*Line           `json:"lines"`
+}
+
+// Get looks up a batter's history against a pitcher. Players who never
+// faced each other get a line with no stats; an unknown player ID is an
+// error.
+func Get(ctx context.Context, src api.DataSource, batter, pitcher models.PersonRef) (*Line, error) {
+	resp, err := src.GetMatchup(ctx, strconv.Itoa(batter.ID), strconv.Itoa(pitcher.ID))
+	if err != nil {
+		return nil, err
+	}
+	return FromStats(resp, batter, pitcher), nil
+}
+
+// FromStats builds a line from a vsPlayer stats response. Names missing
+// from batter and pitcher are filled in from the response.
+func FromStats(resp *models.StatsResponse, batter, pitcher models.PersonRef) *Line {
+	line := &Line{Batter: batter, Pitcher: pitcher}
+
+	for _, group := range resp.Stats {
+		for _, s := range group.Splits {
+			if s.Batter != nil && line.Batter.FullName == "" {
+				line.Batter = *s.Batter
+			}
+			if s.Pitcher != nil && line.Pitcher.FullName == "" {
+				line.Pitcher = *s.Pitcher
+			}
+
+			switch group.Type.DisplayName {
+			case models.StatTypeVsPlayerTotal:
+				line.Career = s.Hitting
+			case models.StatTypeVsPlayer:
+				line.Seasons = append(line.Seasons, s)
+			}
+		}
+	}
+
+	sort.SliceStable(line.Seasons, func(i, j int) bool {
+		return line.Seasons[i].Season < line.Seasons[j].Season
+	})
+	return line
+}
+
+// Lineup looks up every batter on team against pitcher, in batting order
+func Lineup(ctx context.Context, src api.DataSource, team models.BoxscoreTeam, pitcher *models.PersonRef) (*Side, error) {
+	side := &Side{Team: team.Team, Pitcher: pitcher}
+	if pitcher == nil {
+		return side, nil
+	}
+
+	lines, err := api.Batch(ctx, Workers, Batters(team), func(ctx context.Context, batter models.PersonRef) (*Line, error) {
+		return Get(ctx, src, batter, *pitcher)
+	})
+	if err != nil {
+		return nil, err
+	}
+	side.Lines = lines
+	return side, nil
+}
+
+// Batters returns a team's starting lineup once it is posted, otherwise
+// every position player on the roster by name
+func Batters(team models.BoxscoreTeam) []models.PersonRef {
+	ids := team.BattingOrder
+	if len(ids) == 0 {
+		ids = team.Batters
+	}
+
+	var batters []models.PersonRef
+	if len(ids) > 0 {
+		for _, id := range ids {
+			if p, ok := team.Player(id); ok {
+				batters = append(batters, p.Person)
+			}
+		}
+		return batters
+	}
+
+	for _, p := range team.Players {
+		if p.Position.Abbreviation != "P" {
+			batters = append(batters, p.Person)
+		}
+	}
+	sort.Slice(batters, func(i, j int) bool {
+		return batters[i].FullName < batters[j].FullName
+	})
+	return batters
+}
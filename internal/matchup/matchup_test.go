@@ -0,0 +1,63 @@
+package matchup
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+func TestGet(t *testing.T) {
+	src, err := api.LoadFixtures(os.DirFS("testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	judge := models.PersonRef{ID: 592450}
+
+	tests := []struct {
+		name    string
+		pitcher models.PersonRef
+		kind    api.ErrorKind
+		career  int // plate appearances, or -1 for no career line
+		seasons []string
+		pitches string
+	}{
+		{"faced", models.PersonRef{ID: 519242}, api.KindUnknown, 10, []string{"2022", "2024"}, "Chris Sale"},
+		// The API answers an empty stats list for players who never met
+		{"never faced", models.PersonRef{ID: 669203, FullName: "Tarik Skubal"}, api.KindUnknown, -1, nil, "Tarik Skubal"},
+		// A mistyped ID must not look like a pair who never faced
+		{"unknown player", models.PersonRef{ID: 99999999}, api.KindNotFound, 0, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, err := Get(context.Background(), src, judge, tt.pitcher)
+			if kind := api.KindOf(err); kind != tt.kind {
+				t.Fatalf("Get() error = %v, want kind %v", err, tt.kind)
+			}
+			if err != nil {
+				return
+			}
+
+			if line.Pitcher.FullName != tt.pitches {
+				t.Errorf("pitcher = %q, want %q", line.Pitcher.FullName, tt.pitches)
+			}
+			switch {
+			case tt.career < 0 && line.Career != nil:
+				t.Errorf("career = %+v, want none", line.Career)
+			case tt.career >= 0 && (line.Career == nil || line.Career.PlateAppearances != tt.career):
+				t.Errorf("career = %+v, want %d plate appearances", line.Career, tt.career)
+			}
+			var seasons []string
+			for _, s := range line.Seasons {
+				seasons = append(seasons, s.Season)
+			}
+			if !slices.Equal(seasons, tt.seasons) {
+				t.Errorf("seasons = %v, want %v", seasons, tt.seasons)
+			}
+		})
+	}
+}
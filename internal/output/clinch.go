@@ -0,0 +1,84 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgracia13/mlb-cli/internal/clinch"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// PrintClinch outputs each division with the magic and elimination numbers
+// for the division and wild card races
+func (f *Formatter) PrintClinch(standings *models.StandingsResponse, statuses map[int]*clinch.Status, season string) error {
+	if f.format == FormatJSON {
+		var all []*clinch.Status
+		for _, rec := range standings.Records {
+			for _, tr := range rec.TeamRecords {
+				if s, ok := statuses[tr.Team.ID]; ok {
+					all = append(all, s)
+				}
+			}
+		}
+		return printJSON(all)
+	}
+
+	fmt.Printf("\n⚾ MLB Clinching Scenarios - %s\n", season)
+
+	for _, rec := range standings.Records {
+		name := rec.Division.Name
+		if name == "" {
+			name = leagueName(rec.League.ID)
+		}
+		fmt.Printf("\n%s\n", name)
+		fmt.Println(strings.Repeat("─", 90))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "#\tTEAM\tW\tL\tGB\tDIV MAGIC\tDIV ELIM\tWC MAGIC\tWC ELIM\tSTATUS\n")
+
+		for _, tr := range rec.TeamRecords {
+			s, ok := statuses[tr.Team.ID]
+			if !ok {
+				s = &clinch.Status{}
+			}
+			gb := tr.GamesBack
+			if gb == "-" {
+				gb = "—"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				tr.DivisionRank, tr.Team.Name, tr.Wins, tr.Losses, gb,
+				clinchNumber(s.DivisionMagic), clinchNumber(s.DivisionElimination),
+				clinchNumber(s.WildCardMagic), clinchNumber(s.WildCardElimination),
+				clinchStatus(s))
+		}
+		w.Flush()
+	}
+
+	return nil
+}
+
+// clinchNumber formats a magic or elimination number, or "-" when the
+// number does not apply
+func clinchNumber(n *int) string {
+	if n == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *n)
+}
+
+// clinchStatus describes what a team has clinched or been eliminated from
+func clinchStatus(s *clinch.Status) string {
+	switch {
+	case s.ClinchedDivision:
+		return "clinched division"
+	case s.ClinchedWildCard:
+		return "clinched wild card"
+	case s.Eliminated():
+		return "eliminated"
+	case s.EliminatedDivision:
+		return "out of division race"
+	}
+	return ""
+}
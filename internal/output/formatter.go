@@ -6,8 +6,11 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
-	"mlb-cli/internal/models"
+	"github.com/sgracia13/mlb-cli/internal/sabermetrics"
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
 )
 
 // Format represents the output format type
@@ -33,12 +36,14 @@ func ParseFormat(s string) Format {
 
 // Formatter handles output formatting
 type Formatter struct {
-	format Format
+	format   Format
+	location *time.Location // for game start times
 }
 
-// NewFormatter creates a new formatter with the specified format
-func NewFormatter(format Format) *Formatter {
-	return &Formatter{format: format}
+// NewFormatter creates a new formatter with the specified format that
+// shows times in loc
+func NewFormatter(format Format, loc *time.Location) *Formatter {
+	return &Formatter{format: format, location: loc}
 }
 
 // PrintTeams outputs teams in the specified format
@@ -72,7 +77,9 @@ func (f *Formatter) PrintTeams(teams *models.TeamsResponse) error {
 	return nil
 }
 
-// PrintStandings outputs standings in the specified format
+// PrintStandings outputs standings in the specified format. Wild card
+// standings rank teams against the final playoff spot, and league
+// standings rank every team in the league.
 func (f *Formatter) PrintStandings(standings *models.StandingsResponse, season string) error {
 	if f.format == FormatJSON {
 		return printJSON(standings)
@@ -81,13 +88,26 @@ func (f *Formatter) PrintStandings(standings *models.StandingsResponse, season s
 	fmt.Printf("\n⚾ MLB Standings - %s\n", season)
 
 	for _, rec := range standings.Records {
-		fmt.Printf("\n%s\n", rec.Division.Name)
+		switch rec.StandingsType {
+		case api.StandingsWildCard:
+			printWildCard(rec)
+			continue
+		case api.StandingsLeague:
+			printLeagueStandings(rec)
+			continue
+		}
+
+		name := rec.Division.Name
+		if name == "" {
+			name = leagueName(rec.League.ID)
+		}
+		fmt.Printf("\n%s\n", name)
 		fmt.Println(strings.Repeat("─", 75))
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 
 		if f.format == FormatWide {
-			fmt.Fprintf(w, "#\tTEAM\tW\tL\tPCT\tGB\tSTREAK\n")
+			fmt.Fprintf(w, "#\tTEAM\tW\tL\tPCT\tGB\tSTREAK\tRS\tRA\tDIFF\tHOME\tAWAY\tL10\tDIV\tXTRA\t1-RUN\n")
 		} else {
 			fmt.Fprintf(w, "#\tTEAM\tW\tL\tPCT\tGB\tSTREAK\n")
 		}
@@ -97,6 +117,15 @@ func (f *Formatter) PrintStandings(standings *models.StandingsResponse, season s
 			if gb == "-" {
 				gb = "—"
 			}
+			if f.format == FormatWide {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\t%d\t%d\t%+d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					tr.DivisionRank, tr.Team.Name, tr.Wins, tr.Losses, tr.WinningPct, gb, tr.Streak.StreakCode,
+					tr.RunsScored, tr.RunsAllowed, tr.RunDifferential,
+					tr.SplitRecord(models.SplitHome), tr.SplitRecord(models.SplitAway),
+					tr.SplitRecord(models.SplitLastTen), tr.DivisionRecord(rec.Division.ID),
+					tr.SplitRecord(models.SplitExtraInning), tr.SplitRecord(models.SplitOneRun))
+				continue
+			}
 			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\n",
 				tr.DivisionRank,
 				tr.Team.Name,
@@ -113,29 +142,91 @@ func (f *Formatter) PrintStandings(standings *models.StandingsResponse, season s
 	return nil
 }
 
-// PrintSchedule outputs schedule in the specified format
-func (f *Formatter) PrintSchedule(schedule *models.ScheduleResponse, date string) error {
+// printWildCard prints a league's wild card race with a line under the
+// final playoff spot
+func printWildCard(rec models.StandingsRecord) {
+	fmt.Printf("\n%s Wild Card\n", leagueName(rec.League.ID))
+	fmt.Println(strings.Repeat("─", 75))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "#\tTEAM\tW\tL\tPCT\tWCGB\tSTREAK\n")
+	for i, tr := range rec.TeamRecords {
+		if i == api.WildCardSpots {
+			fmt.Fprintln(w, "\t"+strings.Repeat("┄", 20))
+		}
+		gb := tr.WildCardGamesBack
+		if gb == "-" {
+			gb = "—"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\n",
+			tr.WildCardRank, tr.Team.Name, tr.Wins, tr.Losses, tr.WinningPct, gb, tr.Streak.StreakCode)
+	}
+}
+
+// printLeagueStandings prints every team in a league by record
+func printLeagueStandings(rec models.StandingsRecord) {
+	fmt.Printf("\n%s\n", leagueName(rec.League.ID))
+	fmt.Println(strings.Repeat("─", 75))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "#\tTEAM\tW\tL\tPCT\tGB\tSTREAK\n")
+	for _, tr := range rec.TeamRecords {
+		gb := tr.LeagueGamesBack
+		if gb == "-" {
+			gb = "—"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\n",
+			tr.LeagueRank, tr.Team.Name, tr.Wins, tr.Losses, tr.WinningPct, gb, tr.Streak.StreakCode)
+	}
+}
+
+// leagueName returns the name of a league by its ID
+func leagueName(id int) string {
+	switch id {
+	case 103:
+		return "American League"
+	case 104:
+		return "National League"
+	}
+	return fmt.Sprintf("League %d", id)
+}
+
+// PrintSchedule outputs schedule in the specified format. Games spanning
+// more than one date are grouped under a heading per date.
+func (f *Formatter) PrintSchedule(schedule *models.ScheduleResponse, dates string) error {
 	if f.format == FormatJSON {
 		return printJSON(schedule)
 	}
 
-	fmt.Printf("\n⚾ MLB Games - %s\n", date)
+	fmt.Printf("\n⚾ MLB Games - %s\n", dates)
 	fmt.Println(strings.Repeat("─", 80))
 
 	if len(schedule.Dates) == 0 {
-		fmt.Println("No games scheduled for this date.")
+		fmt.Printf("No games scheduled for %s.\n", dates)
 		return nil
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	defer w.Flush()
+	grouped := len(schedule.Dates) > 1
+	for i, d := range schedule.Dates {
+		if grouped {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Println(formatScheduleDate(d.Date))
+		}
 
-	if f.format == FormatWide {
-		fmt.Fprintf(w, "GAME ID\tMATCHUP\tSCORE\tSTATUS\tVENUE\n")
-		fmt.Fprintln(w, strings.Repeat("─", 80))
-	}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		if f.format == FormatWide {
+			fmt.Fprintf(w, "GAME ID\tTIME\tMATCHUP\tSCORE\tSTATUS\tPITCHERS\tSERIES\tVENUE\n")
+			if !grouped {
+				fmt.Fprintln(w, strings.Repeat("─", 80))
+			}
+		}
 
-	for _, d := range schedule.Dates {
 		for _, g := range d.Games {
 			status := g.Status.DetailedState
 			matchup := fmt.Sprintf("%s @ %s", g.Teams.Away.Team.Name, g.Teams.Home.Team.Name)
@@ -145,21 +236,35 @@ func (f *Formatter) PrintSchedule(schedule *models.ScheduleResponse, date string
 				if status == "Final" || status == "Game Over" {
 					score = fmt.Sprintf("%d - %d", g.Teams.Away.Score, g.Teams.Home.Score)
 				}
-				fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", g.GamePk, matchup, score, status, g.Venue.Name)
+				if g.IsDoubleHeader() {
+					matchup += fmt.Sprintf(" (Gm %d)", g.GameNumber)
+				}
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					g.GamePk, g.LocalStartTime(f.location), matchup, score, status, valueOr(g.Pitchers(), "-"), valueOr(g.Series(), "-"), g.Venue.Name)
 			} else {
 				if status == "Final" || status == "Game Over" {
-					fmt.Fprintf(w, "%s\t%d - %d\t[%s]\n",
-						matchup, g.Teams.Away.Score, g.Teams.Home.Score, status)
+					fmt.Fprintf(w, "%s\t%d - %d\t[%s]\t%s\n",
+						matchup, g.Teams.Away.Score, g.Teams.Home.Score, status, g.Venue.Name)
 				} else {
-					fmt.Fprintf(w, "%s\t\t[%s]\n", matchup, status)
+					fmt.Fprintf(w, "%s\t%s\t[%s]\t%s\n", matchup, g.LocalStartTime(f.location), status, g.Venue.Name)
 				}
 			}
 		}
+		w.Flush()
 	}
 
 	return nil
 }
 
+// formatScheduleDate renders a YYYY-MM-DD date as e.g. "Sun, Sep 1 2024"
+func formatScheduleDate(date string) string {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return t.Format("Mon, Jan 2 2006")
+}
+
 // PrintPlayer outputs player info in the specified format
 func (f *Formatter) PrintPlayer(players *models.PlayerSearchResponse, searchName string) error {
 	if f.format == FormatJSON {
@@ -202,8 +307,9 @@ func (f *Formatter) PrintPlayer(players *models.PlayerSearchResponse, searchName
 	return nil
 }
 
-// PrintStats outputs player stats in the specified format
-func (f *Formatter) PrintStats(stats *models.PlayerStatsResponse, season string) error {
+// PrintStats outputs player stats in the specified format. Wide output adds
+// advanced metrics, with wOBA and FIP scaled by each season's constants.
+func (f *Formatter) PrintStats(stats *models.PlayerStatsResponse, season string, constants sabermetrics.Table) error {
 	if f.format == FormatJSON {
 		return printJSON(stats)
 	}
@@ -235,32 +341,58 @@ func (f *Formatter) PrintStats(stats *models.PlayerStatsResponse, season string)
 				seasonLabel = "Career"
 			}
 
+			if split.Fielding != nil {
+				seasonLabel += " " + split.Fielding.Position.Abbreviation
+			}
 			fmt.Printf("\n  %s:\n", seasonLabel)
 
-			stat := split.Stat
-			if groupName == "hitting" {
-				printStatLine("    AVG", stat, "avg")
-				printStatLine("    HR", stat, "homeRuns")
-				printStatLine("    RBI", stat, "rbi")
-				printStatLine("    H", stat, "hits")
-				printStatLine("    AB", stat, "atBats")
-				printStatLine("    OBP", stat, "obp")
-				printStatLine("    SLG", stat, "slg")
-				printStatLine("    OPS", stat, "ops")
-				printStatLine("    SB", stat, "stolenBases")
-				printStatLine("    BB", stat, "baseOnBalls")
-				printStatLine("    SO", stat, "strikeOuts")
-			} else if groupName == "pitching" {
-				printStatLine("    ERA", stat, "era")
-				printStatLine("    W", stat, "wins")
-				printStatLine("    L", stat, "losses")
-				printStatLine("    G", stat, "gamesPlayed")
-				printStatLine("    GS", stat, "gamesStarted")
-				printStatLine("    SV", stat, "saves")
-				printStatLine("    IP", stat, "inningsPitched")
-				printStatLine("    SO", stat, "strikeOuts")
-				printStatLine("    BB", stat, "baseOnBalls")
-				printStatLine("    WHIP", stat, "whip")
+			if h := split.Hitting; h != nil {
+				printStatLine("    AVG", h.Avg)
+				printStatLine("    HR", h.HomeRuns)
+				printStatLine("    RBI", h.RBI)
+				printStatLine("    H", h.Hits)
+				printStatLine("    AB", h.AtBats)
+				printStatLine("    OBP", h.OBP)
+				printStatLine("    SLG", h.SLG)
+				printStatLine("    OPS", h.OPS)
+				printStatLine("    SB", h.StolenBases)
+				printStatLine("    BB", h.BaseOnBalls)
+				printStatLine("    SO", h.StrikeOuts)
+				if f.format == FormatWide {
+					adv := sabermetrics.ComputeHitting(h, constants.For(split.Season))
+					printStatLine("    ISO", sabermetrics.Average(adv.ISO))
+					printStatLine("    BABIP", sabermetrics.Average(adv.BABIP))
+					printStatLine("    K%", sabermetrics.Percent(adv.KPct))
+					printStatLine("    BB%", sabermetrics.Percent(adv.BBPct))
+					printStatLine("    wOBA", sabermetrics.Average(adv.WOBA))
+				}
+			}
+			if p := split.Pitching; p != nil {
+				printStatLine("    ERA", p.ERA)
+				printStatLine("    W", p.Wins)
+				printStatLine("    L", p.Losses)
+				printStatLine("    G", p.GamesPlayed)
+				printStatLine("    GS", p.GamesStarted)
+				printStatLine("    SV", p.Saves)
+				printStatLine("    IP", p.InningsPitched)
+				printStatLine("    SO", p.StrikeOuts)
+				printStatLine("    BB", p.BaseOnBalls)
+				printStatLine("    WHIP", p.WHIP)
+				if f.format == FormatWide {
+					adv := sabermetrics.ComputePitching(p, constants.For(split.Season))
+					printStatLine("    FIP", sabermetrics.ERA(adv.FIP))
+					printStatLine("    K%", sabermetrics.Percent(adv.KPct))
+					printStatLine("    BB%", sabermetrics.Percent(adv.BBPct))
+				}
+			}
+			if fl := split.Fielding; fl != nil {
+				printStatLine("    G", fl.GamesPlayed)
+				printStatLine("    INN", fl.Innings)
+				printStatLine("    PO", fl.PutOuts)
+				printStatLine("    A", fl.Assists)
+				printStatLine("    E", fl.Errors)
+				printStatLine("    DP", fl.DoublePlays)
+				printStatLine("    FPCT", fl.Fielding)
 			}
 		}
 	}
@@ -312,9 +444,11 @@ func printJSON(data interface{}) error {
 	return nil
 }
 
-// printStatLine prints a single stat line
-func printStatLine(label string, stat map[string]interface{}, key string) {
-	if val, ok := stat[key]; ok {
-		fmt.Printf("%-8s %v\n", label+":", val)
+// printStatLine prints a single stat line, skipping rate stats the API
+// left out
+func printStatLine(label string, val interface{}) {
+	if val == "" {
+		return
 	}
+	fmt.Printf("%-8s %v\n", label+":", val)
 }
@@ -0,0 +1,323 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// PrintGame outputs a game summary; live adds the current situation
+func (f *Formatter) PrintGame(feed *models.GameFeedResponse, live bool) error {
+	if f.format == FormatJSON {
+		return printJSON(feed)
+	}
+
+	game := feed.GameData
+	ls := feed.LiveData.Linescore
+
+	fmt.Printf("\n⚾ %s @ %s\n", game.Teams.Away.Name, game.Teams.Home.Name)
+	fmt.Println(strings.Repeat("─", 70))
+	fmt.Printf("Status: %s | Venue: %s | Game ID: %d\n\n", game.Status.DetailedState, game.Venue.Name, feed.GamePk)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	printLinescore(w, ls, game.Teams.Away.Abbreviation, game.Teams.Home.Abbreviation,
+		game.Status.IsFinal(), f.format == FormatWide)
+	w.Flush()
+
+	if live && game.Status.AbstractGameState == "Live" {
+		fmt.Println()
+		fmt.Printf("Inning:  %s %s\n", ls.InningState, ls.CurrentInningOrdinal)
+		fmt.Printf("Count:   %d-%d, %d %s\n", ls.Balls, ls.Strikes, ls.Outs, plural(ls.Outs, "out", "outs"))
+		fmt.Printf("Bases:   %s\n", formatRunners(ls))
+		fmt.Printf("Batter:  %s\n", personName(ls.Offense.Batter))
+		fmt.Printf("Pitcher: %s\n", personName(ls.Defense.Pitcher))
+		if f.format == FormatWide {
+			fmt.Printf("On deck: %s\n", personName(ls.Offense.OnDeck))
+		}
+	}
+
+	if game.Status.IsFinal() {
+		printDecisions(feed.LiveData.Decisions)
+	}
+
+	return nil
+}
+
+// PrintBoxscore outputs both teams' batting and pitching lines with the
+// linescore and pitching decisions
+func (f *Formatter) PrintBoxscore(feed *models.GameFeedResponse) error {
+	game := feed.GameData
+	box := feed.LiveData.Boxscore
+
+	if f.format == FormatJSON {
+		return printJSON(struct {
+			GamePk    int               `json:"gamePk"`
+			Status    models.GameStatus `json:"status"`
+			Linescore models.Linescore  `json:"linescore"`
+			Boxscore  models.Boxscore   `json:"boxscore"`
+			Decisions models.Decisions  `json:"decisions"`
+		}{feed.GamePk, game.Status, feed.LiveData.Linescore, box, feed.LiveData.Decisions})
+	}
+
+	fmt.Printf("\n⚾ Box Score: %s @ %s [%s]\n", game.Teams.Away.Name, game.Teams.Home.Name, game.Status.DetailedState)
+	fmt.Println(strings.Repeat("─", 80))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	printLinescore(w, feed.LiveData.Linescore, game.Teams.Away.Abbreviation, game.Teams.Home.Abbreviation,
+		game.Status.IsFinal(), f.format == FormatWide)
+	w.Flush()
+	printDecisions(feed.LiveData.Decisions)
+
+	for _, team := range []models.BoxscoreTeam{box.Teams.Away, box.Teams.Home} {
+		name := team.Team.Name
+		if name == "" {
+			name = team.Team.Abbreviation
+		}
+		f.printBatting(name, team)
+		f.printPitching(name, team)
+	}
+
+	return nil
+}
+
+// printBatting writes a team's batting lines in lineup order
+func (f *Formatter) printBatting(teamName string, team models.BoxscoreTeam) {
+	fmt.Printf("\n%s Batting\n", teamName)
+	fmt.Println(strings.Repeat("─", 80))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	if f.format == FormatWide {
+		fmt.Fprintf(w, "BATTER\tPOS\tAB\tR\tH\tRBI\tBB\tSO\tHR\tLOB\tAVG\tOPS\n")
+	} else {
+		fmt.Fprintf(w, "BATTER\tPOS\tAB\tR\tH\tRBI\tBB\tSO\tAVG\n")
+	}
+
+	var total models.BattingLine
+	for _, p := range team.Lineup() {
+		name := p.Person.FullName
+		if p.IsSubstitute() {
+			name = "  " + name
+		}
+		b := p.Stats.Batting
+		total.AtBats += b.AtBats
+		total.Runs += b.Runs
+		total.Hits += b.Hits
+		total.RBI += b.RBI
+		total.BaseOnBalls += b.BaseOnBalls
+		total.StrikeOuts += b.StrikeOuts
+		total.HomeRuns += b.HomeRuns
+		total.LeftOnBase += b.LeftOnBase
+
+		if f.format == FormatWide {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t%s\n",
+				name, p.Position.Abbreviation, b.AtBats, b.Runs, b.Hits, b.RBI,
+				b.BaseOnBalls, b.StrikeOuts, b.HomeRuns, b.LeftOnBase,
+				p.SeasonStats.Batting.Avg, p.SeasonStats.Batting.OPS)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n",
+				name, p.Position.Abbreviation, b.AtBats, b.Runs, b.Hits, b.RBI,
+				b.BaseOnBalls, b.StrikeOuts, p.SeasonStats.Batting.Avg)
+		}
+	}
+
+	if f.format == FormatWide {
+		fmt.Fprintf(w, "TOTALS\t\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t\t\n",
+			total.AtBats, total.Runs, total.Hits, total.RBI,
+			total.BaseOnBalls, total.StrikeOuts, total.HomeRuns, total.LeftOnBase)
+	} else {
+		fmt.Fprintf(w, "TOTALS\t\t%d\t%d\t%d\t%d\t%d\t%d\t\n",
+			total.AtBats, total.Runs, total.Hits, total.RBI, total.BaseOnBalls, total.StrikeOuts)
+	}
+}
+
+// printPitching writes a team's pitching lines in order of appearance
+func (f *Formatter) printPitching(teamName string, team models.BoxscoreTeam) {
+	fmt.Printf("\n%s Pitching\n", teamName)
+	fmt.Println(strings.Repeat("─", 80))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	if f.format == FormatWide {
+		fmt.Fprintf(w, "PITCHER\tIP\tH\tR\tER\tBB\tSO\tHR\tPC-ST\tERA\n")
+	} else {
+		fmt.Fprintf(w, "PITCHER\tIP\tH\tR\tER\tBB\tSO\tPC\n")
+	}
+
+	for _, id := range team.Pitchers {
+		p, ok := team.Player(id)
+		if !ok {
+			continue
+		}
+
+		l := p.Stats.Pitching
+		if f.format == FormatWide {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d-%d\t%s\n",
+				p.Person.FullName, l.InningsPitched, l.Hits, l.Runs, l.EarnedRuns,
+				l.BaseOnBalls, l.StrikeOuts, l.HomeRuns, l.NumberOfPitches, l.Strikes,
+				p.SeasonStats.Pitching.ERA)
+		} else {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\n",
+				p.Person.FullName, l.InningsPitched, l.Hits, l.Runs, l.EarnedRuns,
+				l.BaseOnBalls, l.StrikeOuts, l.NumberOfPitches)
+		}
+	}
+}
+
+// PrintPlays outputs plate appearances in the order they happened
+func (f *Formatter) PrintPlays(feed *models.GameFeedResponse, plays []models.Play) error {
+	if f.format == FormatJSON {
+		return printJSON(plays)
+	}
+
+	game := feed.GameData
+	away, home := game.Teams.Away.Abbreviation, game.Teams.Home.Abbreviation
+	fmt.Printf("\n⚾ Plays: %s @ %s [%s]\n", game.Teams.Away.Name, game.Teams.Home.Name, game.Status.DetailedState)
+	fmt.Println(strings.Repeat("─", 100))
+
+	if len(plays) == 0 {
+		fmt.Println("No plays found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	if f.format == FormatWide {
+		fmt.Fprintf(w, "#\tINN\tBATTER\tPITCHER\tEVENT\tRBI\tSCORE\tDESCRIPTION\n")
+	} else {
+		fmt.Fprintf(w, "INN\tBATTER\tPITCHER\tRESULT\tRBI\tSCORE\n")
+	}
+
+	for _, p := range plays {
+		inning := fmt.Sprintf("%s %d", halfArrow(p.About.HalfInning), p.About.Inning)
+		score := fmt.Sprintf("%s %d-%d %s", away, p.Result.AwayScore, p.Result.HomeScore, home)
+		if f.format == FormatWide {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+				p.About.AtBatIndex, inning, p.Matchup.Batter.FullName, p.Matchup.Pitcher.FullName,
+				p.Result.Event, p.Result.RBI, score, p.Result.Description)
+		} else {
+			result := p.Result.Description
+			if result == "" {
+				result = p.Result.Event
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n",
+				inning, p.Matchup.Batter.FullName, p.Matchup.Pitcher.FullName,
+				result, p.Result.RBI, score)
+		}
+	}
+
+	return nil
+}
+
+// Redraw clears the terminal before the next snapshot of a watched
+// resource. JSON output is left alone so snapshots can be streamed.
+func (f *Formatter) Redraw() {
+	if f.format != FormatJSON {
+		fmt.Print("\033[H\033[2J")
+	}
+}
+
+// printLinescore writes the inning-by-inning score with R/H/E totals. In a
+// final game an unplayed half inning is shown as "x".
+func printLinescore(w io.Writer, ls models.Linescore, away, home string, final, wide bool) {
+	innings := ls.ScheduledInnings
+	if len(ls.Innings) > innings {
+		innings = len(ls.Innings)
+	}
+
+	header := "TEAM"
+	for i := 1; i <= innings; i++ {
+		header += fmt.Sprintf("\t%d", i)
+	}
+	header += "\tR\tH\tE"
+	if wide {
+		header += "\tLOB"
+	}
+	fmt.Fprintln(w, header)
+
+	line := func(abbr string, half func(models.LinescoreInning) models.InningTotal, totals models.LinescoreTotals) {
+		row := abbr
+		for i := 0; i < innings; i++ {
+			row += "\t"
+			if i < len(ls.Innings) {
+				if runs := half(ls.Innings[i]).Runs; runs != nil {
+					row += fmt.Sprintf("%d", *runs)
+				} else if final {
+					row += "x"
+				}
+			}
+		}
+		row += fmt.Sprintf("\t%d\t%d\t%d", totals.Runs, totals.Hits, totals.Errors)
+		if wide {
+			row += fmt.Sprintf("\t%d", totals.LeftOnBase)
+		}
+		fmt.Fprintln(w, row)
+	}
+
+	line(away, func(in models.LinescoreInning) models.InningTotal { return in.Away }, ls.Teams.Away)
+	line(home, func(in models.LinescoreInning) models.InningTotal { return in.Home }, ls.Teams.Home)
+}
+
+// printDecisions prints the winning, losing and saving pitchers
+func printDecisions(d models.Decisions) {
+	if d.Winner == nil && d.Loser == nil {
+		return
+	}
+	fmt.Printf("\nW: %s | L: %s", personName(d.Winner), personName(d.Loser))
+	if d.Save != nil {
+		fmt.Printf(" | S: %s", d.Save.FullName)
+	}
+	fmt.Println()
+}
+
+// formatRunners lists the occupied bases, e.g. "1B Betts, 3B Freeman"
+func formatRunners(ls models.Linescore) string {
+	var runners []string
+	for _, base := range []struct {
+		label  string
+		runner *models.PersonRef
+	}{
+		{"1B", ls.Offense.First},
+		{"2B", ls.Offense.Second},
+		{"3B", ls.Offense.Third},
+	} {
+		if base.runner != nil {
+			runners = append(runners, base.label+" "+base.runner.FullName)
+		}
+	}
+
+	if len(runners) == 0 {
+		return "Empty"
+	}
+	return strings.Join(runners, ", ")
+}
+
+// personName returns the player's name, or "-" when there is none
+func personName(p *models.PersonRef) string {
+	if p == nil || p.FullName == "" {
+		return "-"
+	}
+	return p.FullName
+}
+
+// halfArrow returns ▲ for the top of an inning and ▼ for the bottom
+func halfArrow(half string) string {
+	if half == "bottom" {
+		return "▼"
+	}
+	return "▲"
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
@@ -0,0 +1,224 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgracia13/mlb-cli/internal/gamelog"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// PrintGameLog outputs a player's games with their hitting and pitching
+// lines, followed by the totals over each rolling window
+func (f *Formatter) PrintGameLog(log *gamelog.Log) error {
+	if f.format == FormatJSON {
+		return printJSON(log)
+	}
+
+	name := log.Player
+	if name == "" {
+		name = "Player"
+	}
+	label := log.Season
+	if log.Start != "" || log.End != "" {
+		label = fmt.Sprintf("%s to %s", valueOr(log.Start, "start"), valueOr(log.End, "end"))
+	}
+	fmt.Printf("\n⚾ Game Log for %s - %s\n", name, label)
+
+	if log.Hitting == nil && log.Pitching == nil {
+		fmt.Println("\nNo games found.")
+		return nil
+	}
+
+	if s := log.Hitting; s != nil {
+		fmt.Println("\nHitting")
+		fmt.Println(strings.Repeat("─", 80))
+		f.printHittingLog(s)
+		printHittingWindows(s, log.AsOf)
+	}
+	if s := log.Pitching; s != nil {
+		fmt.Println("\nPitching")
+		fmt.Println(strings.Repeat("─", 80))
+		f.printPitchingLog(s)
+		printPitchingWindows(s, log.AsOf)
+	}
+
+	return nil
+}
+
+// printHittingLog prints one batting line per game
+func (f *Formatter) printHittingLog(s *gamelog.Section) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if f.format == FormatWide {
+		fmt.Fprintf(w, "DATE\tOPP\tRESULT\tPA\tAB\tR\tH\t2B\t3B\tHR\tRBI\tBB\tHBP\tSO\tSB\tCS\tLOB\tGAME ID\n")
+	} else {
+		fmt.Fprintf(w, "DATE\tOPP\tRESULT\tAB\tR\tH\tHR\tRBI\tBB\tSO\tSB\n")
+	}
+
+	for _, g := range s.Games {
+		h := g.Hitting
+		if h == nil {
+			continue
+		}
+		if f.format == FormatWide {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n",
+				g.Date, opponent(g), gameResult(g), h.PlateAppearances, h.AtBats, h.Runs, h.Hits,
+				h.Doubles, h.Triples, h.HomeRuns, h.RBI, h.BaseOnBalls, h.HitByPitch,
+				h.StrikeOuts, h.StolenBases, h.CaughtStealing, h.LeftOnBase, gameID(g))
+		} else {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n",
+				g.Date, opponent(g), gameResult(g), h.AtBats, h.Runs, h.Hits,
+				h.HomeRuns, h.RBI, h.BaseOnBalls, h.StrikeOuts, h.StolenBases)
+		}
+	}
+	w.Flush()
+}
+
+// printHittingWindows prints the combined batting line for each rolling
+// window and the whole range
+func printHittingWindows(s *gamelog.Section, asOf string) {
+	fmt.Printf("\n  Rolling totals through %s:\n\n", asOf)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "WINDOW\tG\tPA\tAVG\tOBP\tSLG\tOPS\tHR\tRBI\tBB\tSO\tSB\n")
+	for _, win := range windowRows(s) {
+		h := win.Hitting
+		if h == nil {
+			h = &models.HittingStats{}
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\n",
+			windowLabel(win), win.Games, h.PlateAppearances,
+			valueOr(h.Avg, "-"), valueOr(h.OBP, "-"), valueOr(h.SLG, "-"), valueOr(h.OPS, "-"),
+			h.HomeRuns, h.RBI, h.BaseOnBalls, h.StrikeOuts, h.StolenBases)
+	}
+	w.Flush()
+}
+
+// printPitchingLog prints one pitching line per game
+func (f *Formatter) printPitchingLog(s *gamelog.Section) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if f.format == FormatWide {
+		fmt.Fprintf(w, "DATE\tOPP\tRESULT\tDEC\tIP\tBF\tH\tR\tER\tBB\tHBP\tSO\tHR\tPC\tGAME ID\n")
+	} else {
+		fmt.Fprintf(w, "DATE\tOPP\tRESULT\tDEC\tIP\tH\tR\tER\tBB\tSO\tHR\n")
+	}
+
+	for _, g := range s.Games {
+		p := g.Pitching
+		if p == nil {
+			continue
+		}
+		if f.format == FormatWide {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n",
+				g.Date, opponent(g), gameResult(g), pitchingDecision(p), p.InningsPitched, p.BattersFaced,
+				p.Hits, p.Runs, p.EarnedRuns, p.BaseOnBalls, p.HitByPitch, p.StrikeOuts,
+				p.HomeRuns, p.NumberOfPitches, gameID(g))
+		} else {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\n",
+				g.Date, opponent(g), gameResult(g), pitchingDecision(p), p.InningsPitched,
+				p.Hits, p.Runs, p.EarnedRuns, p.BaseOnBalls, p.StrikeOuts, p.HomeRuns)
+		}
+	}
+	w.Flush()
+}
+
+// printPitchingWindows prints the combined pitching line for each rolling
+// window and the whole range
+func printPitchingWindows(s *gamelog.Section, asOf string) {
+	fmt.Printf("\n  Rolling totals through %s:\n\n", asOf)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "WINDOW\tG\tGS\tW-L\tIP\tERA\tWHIP\tSO\tBB\tHR\tK/9\n")
+	for _, win := range windowRows(s) {
+		p := win.Pitching
+		if p == nil {
+			p = &models.PitchingStats{InningsPitched: "0.0"}
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d-%d\t%s\t%s\t%s\t%d\t%d\t%d\t%s\n",
+			windowLabel(win), win.Games, p.GamesStarted, p.Wins, p.Losses, p.InningsPitched,
+			valueOr(p.ERA, "-"), valueOr(p.WHIP, "-"), p.StrikeOuts, p.BaseOnBalls,
+			p.HomeRuns, valueOr(p.StrikeoutsPer9Inn, "-"))
+	}
+	w.Flush()
+}
+
+// opponent formats a game's opponent as "vs NYY" at home or "@ NYY" away
+func opponent(g models.StatSplit) string {
+	if g.Opponent == nil {
+		return "-"
+	}
+	name, ok := models.GetTeamAbbreviation(g.Opponent.ID)
+	if !ok {
+		name = g.Opponent.Name
+	}
+	if g.IsHome != nil && *g.IsHome {
+		return "vs " + name
+	}
+	return "@ " + name
+}
+
+// gameResult formats whether the player's team won the game
+func gameResult(g models.StatSplit) string {
+	switch {
+	case g.IsWin == nil:
+		return "-"
+	case *g.IsWin:
+		return "W"
+	}
+	return "L"
+}
+
+// pitchingDecision formats a pitcher's decision in one game
+func pitchingDecision(p *models.PitchingStats) string {
+	var parts []string
+	if p.Wins > 0 {
+		parts = append(parts, "W")
+	}
+	if p.Losses > 0 {
+		parts = append(parts, "L")
+	}
+	if p.Saves > 0 {
+		parts = append(parts, "SV")
+	}
+	if p.Holds > 0 {
+		parts = append(parts, "HLD")
+	}
+	if p.BlownSaves > 0 {
+		parts = append(parts, "BS")
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ",")
+}
+
+// gameID returns the game's ID for 'mlb describe boxscore'
+func gameID(g models.StatSplit) string {
+	if g.Game == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", g.Game.GamePk)
+}
+
+// windowRows returns the rolling windows followed by the total
+func windowRows(s *gamelog.Section) []gamelog.Window {
+	return append(append([]gamelog.Window{}, s.Windows...), s.Total)
+}
+
+// windowLabel names a rolling window, e.g. "Last 7 days"
+func windowLabel(w gamelog.Window) string {
+	if w.Days == 0 {
+		return "Total"
+	}
+	return fmt.Sprintf("Last %d days", w.Days)
+}
+
+// valueOr returns s, or fallback when s is empty
+func valueOr(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
@@ -0,0 +1,108 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgracia13/mlb-cli/internal/matchup"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// PrintMatchup outputs a batter's career line against a pitcher followed
+// by each season they faced each other
+func (f *Formatter) PrintMatchup(line *matchup.Line) error {
+	if f.format == FormatJSON {
+		return printJSON(line)
+	}
+
+	fmt.Printf("\n⚾ %s vs %s\n\n", playerName(line.Batter), playerName(line.Pitcher))
+	if line.Career == nil && len(line.Seasons) == 0 {
+		fmt.Println("No plate appearances against this pitcher.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, f.matchupHeader("SEASON"))
+	for _, s := range line.Seasons {
+		if s.Hitting != nil {
+			fmt.Fprintln(w, f.matchupRow(s.Season, s.Hitting))
+		}
+	}
+	if line.Career != nil {
+		fmt.Fprintln(w, f.matchupRow("Career", line.Career))
+	}
+	w.Flush()
+
+	return nil
+}
+
+// PrintLineupMatchups outputs each side's batters against the opposing
+// probable starter
+func (f *Formatter) PrintLineupMatchups(feed *models.GameFeedResponse, sides []*matchup.Side) error {
+	if f.format == FormatJSON {
+		return printJSON(sides)
+	}
+
+	teams := feed.GameData.Teams
+	fmt.Printf("\n⚾ %s @ %s - %s\n", teams.Away.Name, teams.Home.Name, feed.GameData.Datetime.OfficialDate)
+
+	for _, side := range sides {
+		name := side.Team.Abbreviation
+		if name == "" {
+			name = side.Team.Name
+		}
+		if side.Pitcher == nil {
+			fmt.Printf("\n%s batters\n", name)
+			fmt.Println(strings.Repeat("─", 80))
+			fmt.Println("No probable starter announced.")
+			continue
+		}
+
+		fmt.Printf("\n%s batters vs %s\n", name, playerName(*side.Pitcher))
+		fmt.Println(strings.Repeat("─", 80))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, f.matchupHeader("BATTER"))
+		for _, line := range side.Lines {
+			h := line.Career
+			if h == nil {
+				h = &models.HittingStats{}
+			}
+			fmt.Fprintln(w, f.matchupRow(playerName(line.Batter), h))
+		}
+		w.Flush()
+	}
+
+	return nil
+}
+
+// matchupHeader returns the header row of a matchup table
+func (f *Formatter) matchupHeader(label string) string {
+	if f.format == FormatWide {
+		return label + "\tPA\tAB\tH\t2B\t3B\tHR\tRBI\tBB\tSO\tAVG\tOBP\tSLG\tOPS"
+	}
+	return label + "\tPA\tH\tHR\tBB\tSO\tAVG\tOPS"
+}
+
+// matchupRow formats one line of a matchup table
+func (f *Formatter) matchupRow(label string, h *models.HittingStats) string {
+	if f.format == FormatWide {
+		return fmt.Sprintf("%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t%s\t%s\t%s",
+			label, h.PlateAppearances, h.AtBats, h.Hits, h.Doubles, h.Triples, h.HomeRuns,
+			h.RBI, h.BaseOnBalls, h.StrikeOuts, valueOr(h.Avg, "-"), valueOr(h.OBP, "-"),
+			valueOr(h.SLG, "-"), valueOr(h.OPS, "-"))
+	}
+	return fmt.Sprintf("%s\t%d\t%d\t%d\t%d\t%d\t%s\t%s",
+		label, h.PlateAppearances, h.Hits, h.HomeRuns, h.BaseOnBalls, h.StrikeOuts,
+		valueOr(h.Avg, "-"), valueOr(h.OPS, "-"))
+}
+
+// playerName returns a player's name, or their ID when only that is known
+func playerName(p models.PersonRef) string {
+	if p.FullName == "" {
+		return fmt.Sprintf("Player %d", p.ID)
+	}
+	return p.FullName
+}
@@ -0,0 +1,87 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgracia13/mlb-cli/internal/simulate"
+)
+
+// PrintOdds outputs simulated playoff odds by division, best odds first
+func (f *Formatter) PrintOdds(odds []simulate.Odds, season string, remaining, iterations int, seed uint64) error {
+	if f.format == FormatJSON {
+		return printJSON(struct {
+			Season         string          `json:"season"`
+			RemainingGames int             `json:"remainingGames"`
+			Iterations     int             `json:"iterations"`
+			Seed           uint64          `json:"seed"`
+			Teams          []simulate.Odds `json:"teams"`
+		}{season, remaining, iterations, seed, odds})
+	}
+
+	fmt.Printf("\n⚾ Playoff Odds - %s\n", season)
+	fmt.Printf("%d seasons simulated over %d remaining games (seed %d)\n", iterations, remaining, seed)
+
+	var divisions []int
+	byDivision := make(map[int][]simulate.Odds)
+	for _, o := range odds {
+		if _, ok := byDivision[o.DivisionID]; !ok {
+			divisions = append(divisions, o.DivisionID)
+		}
+		byDivision[o.DivisionID] = append(byDivision[o.DivisionID], o)
+	}
+
+	for _, id := range divisions {
+		teams := byDivision[id]
+		sort.SliceStable(teams, func(i, j int) bool {
+			if teams[i].Playoffs != teams[j].Playoffs {
+				return teams[i].Playoffs > teams[j].Playoffs
+			}
+			return teams[i].ProjectedWins > teams[j].ProjectedWins
+		})
+
+		fmt.Printf("\n%s\n", teams[0].DivisionName)
+		fmt.Println(strings.Repeat("─", 80))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		if f.format == FormatWide {
+			fmt.Fprintf(w, "TEAM\tW\tL\tSTRENGTH\tPROJ W\tPROJ L\tDIV\tWC\tBYE\tPLAYOFFS\n")
+		} else {
+			fmt.Fprintf(w, "TEAM\tW\tL\tPROJ W\tDIV\tWC\tBYE\tPLAYOFFS\n")
+		}
+
+		for _, o := range teams {
+			if f.format == FormatWide {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%.3f\t%.1f\t%.1f\t%s\t%s\t%s\t%s\n",
+					o.Name, o.Wins, o.Losses, o.Strength, o.ProjectedWins, o.ProjectedLosses,
+					percent(o.Division), percent(o.WildCard), percent(o.Bye), percent(o.Playoffs))
+			} else {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%.1f\t%s\t%s\t%s\t%s\n",
+					o.Name, o.Wins, o.Losses, o.ProjectedWins,
+					percent(o.Division), percent(o.WildCard), percent(o.Bye), percent(o.Playoffs))
+			}
+		}
+		w.Flush()
+	}
+
+	return nil
+}
+
+// percent formats a probability, showing "<0.1%" or ">99.9%" rather than
+// rounding a live chance to 0 or 100
+func percent(p float64) string {
+	switch {
+	case p == 0:
+		return "0%"
+	case p == 1:
+		return "100%"
+	case p < 0.001:
+		return "<0.1%"
+	case p > 0.999:
+		return ">99.9%"
+	}
+	return fmt.Sprintf("%.1f%%", p*100)
+}
@@ -0,0 +1,114 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sgracia13/mlb-cli/internal/sabermetrics"
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// PrintSplits outputs a matrix of a player's situational splits, one row per
+// split in the order of codes, with a gap between categories. Wide output
+// adds advanced metrics, with wOBA and FIP scaled by the season's constants.
+func (f *Formatter) PrintSplits(splits *models.StatsResponse, query api.SplitsQuery, constants sabermetrics.Table) error {
+	if f.format == FormatJSON {
+		return printJSON(splits)
+	}
+
+	order := make(map[string]int, len(query.SitCodes))
+	for i, code := range query.SitCodes {
+		order[code] = i
+	}
+	var rows []models.StatSplit
+	for _, group := range splits.Stats {
+		for _, s := range group.Splits {
+			if s.Split != nil {
+				rows = append(rows, s)
+			}
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return order[rows[i].Split.Code] < order[rows[j].Split.Code]
+	})
+
+	name := "Player " + query.PlayerID
+	if len(rows) > 0 && rows[0].Player != nil {
+		name = rows[0].Player.FullName
+	}
+	fmt.Printf("\n⚾ %s Splits for %s - %s\n\n", capitalize(query.Group), name, query.Season)
+
+	if len(rows) == 0 {
+		fmt.Println("No splits found.")
+		return nil
+	}
+
+	c := constants.For(query.Season)
+	wide := f.format == FormatWide
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	var header string
+	if query.Group == models.GroupPitching {
+		header = "SPLIT\tBF\tIP\tH\tHR\tBB\tSO\tERA\tWHIP\tAVG\tOPS"
+		if wide {
+			header += "\tOBP\tSLG\tK%\tBB%\tFIP"
+		}
+	} else {
+		header = "SPLIT\tPA\tAB\tH\tHR\tRBI\tBB\tSO\tAVG\tOBP\tSLG\tOPS"
+		if wide {
+			header += "\t2B\t3B\tSB\tISO\tBABIP\tK%\tBB%\twOBA"
+		}
+	}
+	fmt.Fprintln(w, header)
+	// An empty row that keeps every column aligned across categories
+	gap := strings.Repeat("\t", strings.Count(header, "\t"))
+
+	for i, s := range rows {
+		if i > 0 && api.SplitCategory(s.Split.Code) != api.SplitCategory(rows[i-1].Split.Code) {
+			fmt.Fprintln(w, gap)
+		}
+
+		if p := s.Pitching; p != nil {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%d\t%d\t%d\t%s\t%s\t%s\t%s",
+				s.Split.Description, p.BattersFaced, valueOr(p.InningsPitched, "0.0"), p.Hits,
+				p.HomeRuns, p.BaseOnBalls, p.StrikeOuts, valueOr(p.ERA, "-"),
+				valueOr(p.WHIP, "-"), valueOr(p.Avg, "-"), valueOr(p.OPS, "-"))
+			if wide {
+				adv := sabermetrics.ComputePitching(p, c)
+				fmt.Fprintf(w, "\t%s\t%s\t%s\t%s\t%s",
+					valueOr(p.OBP, "-"), valueOr(p.SLG, "-"), sabermetrics.Percent(adv.KPct),
+					sabermetrics.Percent(adv.BBPct), sabermetrics.ERA(adv.FIP))
+			}
+		} else if h := s.Hitting; h != nil {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t%s\t%s\t%s",
+				s.Split.Description, h.PlateAppearances, h.AtBats, h.Hits, h.HomeRuns, h.RBI,
+				h.BaseOnBalls, h.StrikeOuts, valueOr(h.Avg, "-"), valueOr(h.OBP, "-"),
+				valueOr(h.SLG, "-"), valueOr(h.OPS, "-"))
+			if wide {
+				adv := sabermetrics.ComputeHitting(h, c)
+				fmt.Fprintf(w, "\t%d\t%d\t%d\t%s\t%s\t%s\t%s\t%s",
+					h.Doubles, h.Triples, h.StolenBases, sabermetrics.Average(adv.ISO),
+					sabermetrics.Average(adv.BABIP), sabermetrics.Percent(adv.KPct),
+					sabermetrics.Percent(adv.BBPct), sabermetrics.Average(adv.WOBA))
+			}
+		} else {
+			fmt.Fprintf(w, "%s", s.Split.Description)
+		}
+		fmt.Fprintln(w)
+	}
+	w.Flush()
+
+	return nil
+}
+
+// capitalize upper-cases the first letter of s, e.g. "Hitting"
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
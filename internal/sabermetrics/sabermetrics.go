@@ -0,0 +1,203 @@
+// Package sabermetrics derives advanced metrics from the counting stats the
+// API returns: ISO, BABIP, K%, BB% and wOBA for hitters, and FIP, K% and
+// BB% for pitchers.
+//
+// wOBA and FIP depend on the league's run environment, so both are scaled
+// by per-season constants. DefaultConstants holds the published values;
+// the config file can override or extend them.
+package sabermetrics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// Constants are one season's wOBA linear weights and FIP constant
+type Constants struct {
+	BB     float64 `yaml:"bb"` // unintentional walks
+	HBP    float64 `yaml:"hbp"`
+	Single float64 `yaml:"single"`
+	Double float64 `yaml:"double"`
+	Triple float64 `yaml:"triple"`
+	HR     float64 `yaml:"homeRun"`
+	FIP    float64 `yaml:"fip"` // added to FIP to put it on the ERA scale
+}
+
+// Table maps seasons, e.g. "2024", to their constants
+type Table map[string]Constants
+
+// DefaultConstants are the published linear weights and FIP constants
+var DefaultConstants = Table{
+	"2019": {BB: 0.690, HBP: 0.719, Single: 0.870, Double: 1.217, Triple: 1.529, HR: 1.940, FIP: 3.214},
+	"2020": {BB: 0.699, HBP: 0.728, Single: 0.883, Double: 1.238, Triple: 1.558, HR: 1.979, FIP: 3.191},
+	"2021": {BB: 0.692, HBP: 0.722, Single: 0.879, Double: 1.242, Triple: 1.568, HR: 2.007, FIP: 3.170},
+	"2022": {BB: 0.689, HBP: 0.720, Single: 0.884, Double: 1.261, Triple: 1.601, HR: 2.072, FIP: 3.112},
+	"2023": {BB: 0.696, HBP: 0.726, Single: 0.883, Double: 1.244, Triple: 1.569, HR: 2.004, FIP: 3.255},
+	"2024": {BB: 0.689, HBP: 0.720, Single: 0.882, Double: 1.254, Triple: 1.590, HR: 2.050, FIP: 3.166},
+}
+
+// Merge returns the table with overrides applied field by field. A field
+// left at zero keeps the season's current value, or for a season not in
+// the table, the value For would have returned for it.
+func (t Table) Merge(overrides Table) Table {
+	merged := make(Table, len(t)+len(overrides))
+	for season, c := range t {
+		merged[season] = c
+	}
+	for season, o := range overrides {
+		merged[season] = t.For(season).merge(o)
+	}
+	return merged
+}
+
+// merge returns c with the non-zero fields of o
+func (c Constants) merge(o Constants) Constants {
+	if o.BB != 0 {
+		c.BB = o.BB
+	}
+	if o.HBP != 0 {
+		c.HBP = o.HBP
+	}
+	if o.Single != 0 {
+		c.Single = o.Single
+	}
+	if o.Double != 0 {
+		c.Double = o.Double
+	}
+	if o.Triple != 0 {
+		c.Triple = o.Triple
+	}
+	if o.HR != 0 {
+		c.HR = o.HR
+	}
+	if o.FIP != 0 {
+		c.FIP = o.FIP
+	}
+	return c
+}
+
+// For returns the constants for a season. A season without its own entry,
+// such as one in progress or a career line, uses the latest season before
+// it, or the latest season overall.
+func (t Table) For(season string) Constants {
+	if c, ok := t[season]; ok {
+		return c
+	}
+
+	seasons := make([]string, 0, len(t))
+	for s := range t {
+		seasons = append(seasons, s)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(seasons)))
+	for _, s := range seasons {
+		if season == "" || s < season {
+			return t[s]
+		}
+	}
+	if len(seasons) > 0 {
+		// Every known season is later; the closest is the earliest
+		return t[seasons[len(seasons)-1]]
+	}
+	return Constants{}
+}
+
+// Hitting holds a batter's derived metrics. A nil metric could not be
+// computed, e.g. K% without plate appearances.
+type Hitting struct {
+	ISO   *float64 `json:"iso,omitempty"`
+	BABIP *float64 `json:"babip,omitempty"`
+	KPct  *float64 `json:"strikeoutRate,omitempty"`
+	BBPct *float64 `json:"walkRate,omitempty"`
+	WOBA  *float64 `json:"woba,omitempty"`
+}
+
+// Pitching holds a pitcher's derived metrics
+type Pitching struct {
+	FIP   *float64 `json:"fip,omitempty"`
+	KPct  *float64 `json:"strikeoutRate,omitempty"`
+	BBPct *float64 `json:"walkRate,omitempty"`
+}
+
+// ComputeHitting derives a batter's metrics using the season's constants
+func ComputeHitting(s *models.HittingStats, c Constants) Hitting {
+	singles := s.Hits - s.Doubles - s.Triples - s.HomeRuns
+	totalBases := s.TotalBases
+	if totalBases == 0 {
+		totalBases = singles + 2*s.Doubles + 3*s.Triples + 4*s.HomeRuns
+	}
+	pa := s.PlateAppearances
+	if pa == 0 {
+		pa = s.AtBats + s.BaseOnBalls + s.HitByPitch + s.SacFlies + s.SacBunts
+	}
+	unintentional := s.BaseOnBalls - s.IntentionalWalks
+
+	weighted := c.BB*float64(unintentional) +
+		c.HBP*float64(s.HitByPitch) +
+		c.Single*float64(singles) +
+		c.Double*float64(s.Doubles) +
+		c.Triple*float64(s.Triples) +
+		c.HR*float64(s.HomeRuns)
+
+	return Hitting{
+		ISO:   ratio(float64(totalBases-s.Hits), s.AtBats),
+		BABIP: ratio(float64(s.Hits-s.HomeRuns), s.AtBats-s.StrikeOuts-s.HomeRuns+s.SacFlies),
+		KPct:  ratio(float64(s.StrikeOuts), pa),
+		BBPct: ratio(float64(s.BaseOnBalls), pa),
+		WOBA:  ratio(weighted, s.AtBats+unintentional+s.SacFlies+s.HitByPitch),
+	}
+}
+
+// ComputePitching derives a pitcher's metrics using the season's constants
+func ComputePitching(s *models.PitchingStats, c Constants) Pitching {
+	outs := s.TotalOuts()
+
+	var fip *float64
+	if outs > 0 {
+		v := float64(13*s.HomeRuns+3*(s.BaseOnBalls+s.HitByPitch)-2*s.StrikeOuts)/(float64(outs)/3) + c.FIP
+		fip = &v
+	}
+
+	return Pitching{
+		FIP:   fip,
+		KPct:  ratio(float64(s.StrikeOuts), s.BattersFaced),
+		BBPct: ratio(float64(s.BaseOnBalls), s.BattersFaced),
+	}
+}
+
+// ratio returns n/d, or nil when d is not positive
+func ratio(n float64, d int) *float64 {
+	if d <= 0 {
+		return nil
+	}
+	v := n / float64(d)
+	return &v
+}
+
+// Average formats a rate the way batting averages are written, e.g. ".345"
+// or "1.050", and "-" for a metric that could not be computed
+func Average(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	s := strconv.FormatFloat(*v, 'f', 3, 64)
+	return strings.TrimPrefix(s, "0")
+}
+
+// Percent formats a rate as a percentage, e.g. "22.5%"
+func Percent(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return strconv.FormatFloat(*v*100, 'f', 1, 64) + "%"
+}
+
+// ERA formats a metric on the ERA scale, e.g. "3.45"
+func ERA(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return strconv.FormatFloat(*v, 'f', 2, 64)
+}
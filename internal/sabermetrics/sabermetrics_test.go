@@ -0,0 +1,171 @@
+package sabermetrics
+
+import (
+	"testing"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+func TestMerge(t *testing.T) {
+	base := Table{
+		"2023": {BB: 0.696, HBP: 0.726, Single: 0.883, Double: 1.244, Triple: 1.569, HR: 2.004, FIP: 3.255},
+		"2024": {BB: 0.689, HBP: 0.720, Single: 0.882, Double: 1.254, Triple: 1.590, HR: 2.050, FIP: 3.166},
+	}
+	merged := base.Merge(Table{
+		"2024": {FIP: 3.2},
+		"2025": {HR: 2.1, FIP: 3.1},
+		"2010": {BB: 0.7},
+	})
+
+	tests := []struct {
+		season string
+		want   Constants
+	}{
+		{"2023", base["2023"]},
+		{"2024", Constants{BB: 0.689, HBP: 0.720, Single: 0.882, Double: 1.254, Triple: 1.590, HR: 2.050, FIP: 3.2}},
+		// New seasons fill in from the season For would have used
+		{"2025", Constants{BB: 0.689, HBP: 0.720, Single: 0.882, Double: 1.254, Triple: 1.590, HR: 2.1, FIP: 3.1}},
+		{"2010", Constants{BB: 0.7, HBP: 0.726, Single: 0.883, Double: 1.244, Triple: 1.569, HR: 2.004, FIP: 3.255}},
+	}
+
+	for _, tt := range tests {
+		if got := merged[tt.season]; got != tt.want {
+			t.Errorf("Merge()[%s] = %+v, want %+v", tt.season, got, tt.want)
+		}
+	}
+	if base["2024"].FIP != 3.166 {
+		t.Error("Merge modified the original table")
+	}
+}
+
+func TestFor(t *testing.T) {
+	table := Table{"2019": {FIP: 1}, "2021": {FIP: 2}, "2024": {FIP: 3}}
+
+	tests := []struct {
+		season string
+		want   float64
+	}{
+		{"2021", 2},
+		{"2022", 2}, // latest earlier season
+		{"2026", 3}, // in progress
+		{"", 3},     // career line
+		{"2010", 1}, // before every season
+	}
+
+	for _, tt := range tests {
+		if got := table.For(tt.season).FIP; got != tt.want {
+			t.Errorf("For(%q).FIP = %v, want %v", tt.season, got, tt.want)
+		}
+	}
+	if got := (Table{}).For("2024"); got != (Constants{}) {
+		t.Errorf("empty table For = %+v, want zero", got)
+	}
+}
+
+// c2024 are the 2024 constants
+var c2024 = DefaultConstants["2024"]
+
+func TestComputeHitting(t *testing.T) {
+	tests := []struct {
+		name                          string
+		stats                         models.HittingStats
+		iso, babip, kPct, bbPct, woba string
+	}{
+		{
+			// Aaron Judge, 2024
+			name: "full season",
+			stats: models.HittingStats{
+				PlateAppearances: 704, AtBats: 559, Hits: 180, Doubles: 36, Triples: 1, HomeRuns: 58,
+				TotalBases: 392, BaseOnBalls: 133, IntentionalWalks: 20, HitByPitch: 9, StrikeOuts: 171, SacFlies: 3,
+			},
+			iso: ".379", babip: ".366", kPct: "24.3%", bbPct: "18.9%", woba: ".475",
+		},
+		{
+			// Total bases and plate appearances missing from the line
+			name: "computed totals",
+			stats: models.HittingStats{
+				AtBats: 10, Hits: 4, Doubles: 1, HomeRuns: 1, BaseOnBalls: 1, HitByPitch: 1, SacFlies: 1, StrikeOuts: 2,
+			},
+			iso: ".400", babip: ".375", kPct: "15.4%", bbPct: "7.7%", woba: ".498",
+		},
+		{
+			name:  "no plate appearances",
+			stats: models.HittingStats{},
+			iso:   "-", babip: "-", kPct: "-", bbPct: "-", woba: "-",
+		},
+		{
+			name:  "walks only",
+			stats: models.HittingStats{PlateAppearances: 2, BaseOnBalls: 2},
+			iso:   "-", babip: "-", kPct: "0.0%", bbPct: "100.0%", woba: ".689",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := ComputeHitting(&tt.stats, c2024)
+			for _, m := range []struct{ name, got, want string }{
+				{"ISO", Average(h.ISO), tt.iso},
+				{"BABIP", Average(h.BABIP), tt.babip},
+				{"K%", Percent(h.KPct), tt.kPct},
+				{"BB%", Percent(h.BBPct), tt.bbPct},
+				{"wOBA", Average(h.WOBA), tt.woba},
+			} {
+				if m.got != m.want {
+					t.Errorf("%s = %s, want %s", m.name, m.got, m.want)
+				}
+			}
+		})
+	}
+}
+
+func TestComputePitching(t *testing.T) {
+	tests := []struct {
+		name             string
+		stats            models.PitchingStats
+		constants        Constants
+		fip, kPct, bbPct string
+	}{
+		{
+			// Tarik Skubal, 2024
+			name: "full season",
+			stats: models.PitchingStats{
+				InningsPitched: "192.0", BattersFaced: 723, HomeRuns: 15, BaseOnBalls: 35, HitByPitch: 3, StrikeOuts: 228,
+			},
+			constants: c2024,
+			fip:       "2.40", kPct: "31.5%", bbPct: "4.8%",
+		},
+		{
+			name:      "outs win over innings",
+			stats:     models.PitchingStats{Outs: 3, InningsPitched: "9.0", BattersFaced: 4, HomeRuns: 1, StrikeOuts: 1},
+			constants: Constants{FIP: 3},
+			fip:       "14.00", kPct: "25.0%", bbPct: "0.0%",
+		},
+		{
+			name:      "partial innings",
+			stats:     models.PitchingStats{InningsPitched: "1.2", BattersFaced: 6, BaseOnBalls: 1, StrikeOuts: 2},
+			constants: Constants{FIP: 3},
+			fip:       "2.40", kPct: "33.3%", bbPct: "16.7%",
+		},
+		{
+			name:      "no outs",
+			stats:     models.PitchingStats{InningsPitched: "0.0"},
+			constants: c2024,
+			fip:       "-", kPct: "-", bbPct: "-",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := ComputePitching(&tt.stats, tt.constants)
+			for _, m := range []struct{ name, got, want string }{
+				{"FIP", ERA(p.FIP), tt.fip},
+				{"K%", Percent(p.KPct), tt.kPct},
+				{"BB%", Percent(p.BBPct), tt.bbPct},
+			} {
+				if m.got != m.want {
+					t.Errorf("%s = %s, want %s", m.name, m.got, m.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+// Package simulate estimates playoff odds by playing out the rest of a
+// season many times.
+//
+// Each team's strength is its Pythagorean winning percentage from runs
+// scored and allowed, and every remaining game is decided by the log5
+// chance of the home team beating the away team. Each league sends its
+// three division winners and three wild cards to the postseason, and the
+// two best division winners get a bye.
+package simulate
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"sort"
+
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// Playoff format
+const (
+	WildCardsPerLeague = api.WildCardSpots
+	ByesPerLeague      = 2
+)
+
+// pythagoreanExponent is the exponent used to turn runs scored and allowed
+// into a winning percentage
+const pythagoreanExponent = 1.83
+
+// chunkSize is the number of seasons simulated per unit of work
+const chunkSize = 250
+
+// Team is a team's current record and strength
+type Team struct {
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	DivisionID   int     `json:"divisionId"`
+	DivisionName string  `json:"divisionName"`
+	LeagueID     int     `json:"leagueId"`
+	Wins         int     `json:"wins"`
+	Losses       int     `json:"losses"`
+	Strength     float64 `json:"strength"` // expected winning percentage
+}
+
+// Game is a remaining game between two teams, by team ID
+type Game struct {
+	Home int
+	Away int
+}
+
+// Options control a simulation
+type Options struct {
+	Iterations int
+	Seed       uint64
+	Workers    int // 0 uses every CPU
+}
+
+// Odds are a team's simulated postseason chances, as fractions of 1
+type Odds struct {
+	Team
+	ProjectedWins   float64 `json:"projectedWins"`
+	ProjectedLosses float64 `json:"projectedLosses"`
+	Division        float64 `json:"division"`
+	WildCard        float64 `json:"wildCard"`
+	Bye             float64 `json:"bye"`
+	Playoffs        float64 `json:"playoffs"`
+}
+
+// TeamsFromStandings builds the teams of a season from its division
+// standings
+func TeamsFromStandings(standings *models.StandingsResponse) []Team {
+	var teams []Team
+	for _, rec := range standings.Records {
+		for _, tr := range rec.TeamRecords {
+			teams = append(teams, Team{
+				ID:           tr.Team.ID,
+				Name:         tr.Team.Name,
+				DivisionID:   rec.Division.ID,
+				DivisionName: rec.Division.Name,
+				LeagueID:     rec.League.ID,
+				Wins:         tr.Wins,
+				Losses:       tr.Losses,
+				Strength:     pythagorean(tr.RunsScored, tr.RunsAllowed),
+			})
+		}
+	}
+	return teams
+}
+
+// GamesFromSchedule returns the games in a schedule that have yet to be
+// played. Postponed games are skipped, since their makeup dates appear in
+// the schedule separately, and a suspended game listed on both its original
+// and resumption dates is played once.
+func GamesFromSchedule(schedule *models.ScheduleResponse) []Game {
+	var games []Game
+	seen := make(map[int]bool)
+	for _, d := range schedule.Dates {
+		for _, g := range d.Games {
+			if g.IsOver() || g.IsCalledOff() || seen[g.GamePk] {
+				continue
+			}
+			seen[g.GamePk] = true
+			games = append(games, Game{Home: g.Teams.Home.Team.ID, Away: g.Teams.Away.Team.ID})
+		}
+	}
+	return games
+}
+
+// pythagorean returns the winning percentage expected from runs scored
+// and allowed
+func pythagorean(scored, allowed int) float64 {
+	if scored+allowed == 0 {
+		return 0.5
+	}
+	rs := math.Pow(float64(scored), pythagoreanExponent)
+	ra := math.Pow(float64(allowed), pythagoreanExponent)
+	return rs / (rs + ra)
+}
+
+// log5 returns the chance that a team of strength a beats a team of
+// strength b
+func log5(a, b float64) float64 {
+	num := a * (1 - b)
+	den := num + b*(1-a)
+	if den == 0 {
+		return 0.5
+	}
+	return num / den
+}
+
+// tally counts the outcomes of a batch of simulated seasons
+type tally struct {
+	wins     []int
+	division []int
+	wildCard []int
+	bye      []int
+}
+
+func newTally(n int) tally {
+	return tally{
+		wins:     make([]int, n),
+		division: make([]int, n),
+		wildCard: make([]int, n),
+		bye:      make([]int, n),
+	}
+}
+
+func (t tally) add(o tally) {
+	for i := range t.wins {
+		t.wins[i] += o.wins[i]
+		t.division[i] += o.division[i]
+		t.wildCard[i] += o.wildCard[i]
+		t.bye[i] += o.bye[i]
+	}
+}
+
+// Run simulates the remaining games opts.Iterations times across
+// goroutines and returns each team's odds in the order of teams. Each
+// season draws from its own generator seeded by opts.Seed and its index,
+// so results are reproducible whatever the number of workers.
+func Run(ctx context.Context, teams []Team, games []Game, opts Options) ([]Odds, error) {
+	s := newSeason(teams, games)
+
+	var chunks [][2]int
+	for start := 0; start < opts.Iterations; start += chunkSize {
+		chunks = append(chunks, [2]int{start, min(start+chunkSize, opts.Iterations)})
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	tallies, err := api.Batch(ctx, workers, chunks, func(ctx context.Context, chunk [2]int) (tally, error) {
+		t := newTally(len(teams))
+		wins := make([]int, len(teams))
+		for i := chunk[0]; i < chunk[1]; i++ {
+			if err := ctx.Err(); err != nil {
+				return t, err
+			}
+			r := rand.New(rand.NewPCG(opts.Seed, uint64(i)))
+			s.play(r, wins, t)
+		}
+		return t, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total := newTally(len(teams))
+	for _, t := range tallies {
+		total.add(t)
+	}
+
+	left := make([]int, len(teams))
+	for _, g := range s.games {
+		left[g[0]]++
+		left[g[1]]++
+	}
+
+	n := float64(max(opts.Iterations, 1))
+	odds := make([]Odds, len(teams))
+	for i, team := range teams {
+		wins := float64(total.wins[i]) / n
+		odds[i] = Odds{
+			Team:            team,
+			ProjectedWins:   wins,
+			ProjectedLosses: float64(team.Wins+team.Losses+left[i]) - wins,
+			Division:        float64(total.division[i]) / n,
+			WildCard:        float64(total.wildCard[i]) / n,
+			Bye:             float64(total.bye[i]) / n,
+			Playoffs:        float64(total.division[i]+total.wildCard[i]) / n,
+		}
+	}
+	return odds, nil
+}
+
+// season holds the precomputed structure shared by every simulation
+type season struct {
+	teams     []Team
+	games     [][2]int  // home and away team indexes
+	homeOdds  []float64 // chance the home team wins each game
+	divisions [][]int   // team indexes by division
+	leagues   [][]int   // team indexes by league
+}
+
+func newSeason(teams []Team, games []Game) *season {
+	s := &season{teams: teams}
+
+	index := make(map[int]int, len(teams))
+	divisions := make(map[int]int)
+	leagues := make(map[int]int)
+	for i, t := range teams {
+		index[t.ID] = i
+		if _, ok := divisions[t.DivisionID]; !ok {
+			divisions[t.DivisionID] = len(s.divisions)
+			s.divisions = append(s.divisions, nil)
+		}
+		if _, ok := leagues[t.LeagueID]; !ok {
+			leagues[t.LeagueID] = len(s.leagues)
+			s.leagues = append(s.leagues, nil)
+		}
+		s.divisions[divisions[t.DivisionID]] = append(s.divisions[divisions[t.DivisionID]], i)
+		s.leagues[leagues[t.LeagueID]] = append(s.leagues[leagues[t.LeagueID]], i)
+	}
+
+	for _, g := range games {
+		home, ok := index[g.Home]
+		if !ok {
+			continue
+		}
+		away, ok := index[g.Away]
+		if !ok {
+			continue
+		}
+		s.games = append(s.games, [2]int{home, away})
+		s.homeOdds = append(s.homeOdds, log5(teams[home].Strength, teams[away].Strength))
+	}
+	return s
+}
+
+// play simulates one season into wins and records the outcome in t
+func (s *season) play(r *rand.Rand, wins []int, t tally) {
+	for i, team := range s.teams {
+		wins[i] = team.Wins
+	}
+	for i, g := range s.games {
+		if r.Float64() < s.homeOdds[i] {
+			wins[g[0]]++
+		} else {
+			wins[g[1]]++
+		}
+	}
+
+	// Random tiebreakers stand in for head-to-head records and tiebreak games
+	tiebreak := make([]float64, len(s.teams))
+	for i := range tiebreak {
+		tiebreak[i] = r.Float64()
+	}
+	better := func(a, b int) bool {
+		if wins[a] != wins[b] {
+			return wins[a] > wins[b]
+		}
+		return tiebreak[a] > tiebreak[b]
+	}
+
+	winner := make([]bool, len(s.teams))
+	for _, division := range s.divisions {
+		best := division[0]
+		for _, i := range division[1:] {
+			if better(i, best) {
+				best = i
+			}
+		}
+		winner[best] = true
+		t.division[best]++
+	}
+
+	for _, league := range s.leagues {
+		var leaders, others []int
+		for _, i := range league {
+			t.wins[i] += wins[i]
+			if winner[i] {
+				leaders = append(leaders, i)
+			} else {
+				others = append(others, i)
+			}
+		}
+
+		sort.Slice(leaders, func(a, b int) bool { return better(leaders[a], leaders[b]) })
+		for _, i := range leaders[:min(ByesPerLeague, len(leaders))] {
+			t.bye[i]++
+		}
+
+		sort.Slice(others, func(a, b int) bool { return better(others[a], others[b]) })
+		for _, i := range others[:min(WildCardsPerLeague, len(others))] {
+			t.wildCard[i]++
+		}
+	}
+}
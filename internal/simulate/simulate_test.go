@@ -0,0 +1,212 @@
+package simulate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// league returns one league of two three-team divisions with the given
+// wins, out of 162 games each
+func league(wins ...int) []Team {
+	teams := make([]Team, len(wins))
+	for i, w := range wins {
+		teams[i] = Team{
+			ID:         i + 1,
+			DivisionID: 201 + i/3,
+			LeagueID:   103,
+			Wins:       w,
+			Losses:     162 - w,
+			Strength:   float64(w) / 162,
+		}
+	}
+	return teams
+}
+
+// roundRobin returns every pairing of the teams, each hosted once by both
+func roundRobin(teams []Team) []Game {
+	var games []Game
+	for _, home := range teams {
+		for _, away := range teams {
+			if home.ID != away.ID {
+				games = append(games, Game{Home: home.ID, Away: away.ID})
+			}
+		}
+	}
+	return games
+}
+
+func TestRunSeasonOver(t *testing.T) {
+	teams := league(95, 90, 80, 88, 85, 70)
+
+	odds, err := Run(context.Background(), teams, nil, Options{Iterations: 100, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// division, wild card and bye for each team
+	want := [][3]float64{
+		{1, 0, 1},
+		{0, 1, 0},
+		{0, 1, 0},
+		{1, 0, 1},
+		{0, 1, 0},
+		{0, 0, 0},
+	}
+	for i, o := range odds {
+		got := [3]float64{o.Division, o.WildCard, o.Bye}
+		if got != want[i] {
+			t.Errorf("team %d odds = %v, want %v", o.ID, got, want[i])
+		}
+		if o.ProjectedWins != float64(o.Wins) || o.ProjectedLosses != float64(o.Losses) {
+			t.Errorf("team %d projected %v-%v, want %d-%d", o.ID, o.ProjectedWins, o.ProjectedLosses, o.Wins, o.Losses)
+		}
+	}
+}
+
+func TestRunSeeded(t *testing.T) {
+	teams := league(80, 79, 75, 78, 78, 70)
+	games := roundRobin(teams)
+	run := func(seed uint64, workers int) []Odds {
+		t.Helper()
+		odds, err := Run(context.Background(), teams, games, Options{Iterations: 1000, Seed: seed, Workers: workers})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return odds
+	}
+
+	odds := run(42, 1)
+	if !reflect.DeepEqual(run(42, 8), odds) {
+		t.Error("same seed with more workers gave different odds")
+	}
+	if reflect.DeepEqual(run(7, 1), odds) {
+		t.Error("different seeds gave identical odds")
+	}
+
+	var division, wildCard, bye float64
+	for _, o := range odds {
+		for _, p := range []float64{o.Division, o.WildCard, o.Bye, o.Playoffs} {
+			if p < 0 || p > 1 {
+				t.Errorf("team %d has odds %v outside [0, 1]", o.ID, p)
+			}
+		}
+		if math.Abs(o.Playoffs-(o.Division+o.WildCard)) > 1e-9 {
+			t.Errorf("team %d playoffs = %v, want division + wild card", o.ID, o.Playoffs)
+		}
+		// Each team plays the other five home and away
+		if got := o.ProjectedWins + o.ProjectedLosses; math.Abs(got-172) > 1e-9 {
+			t.Errorf("team %d projected %v games, want 172", o.ID, got)
+		}
+		division += o.Division
+		wildCard += o.WildCard
+		bye += o.Bye
+	}
+	for _, sum := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"division", division, 2},
+		{"wild card", wildCard, WildCardsPerLeague},
+		{"bye", bye, ByesPerLeague},
+	} {
+		if math.Abs(sum.got-sum.want) > 1e-9 {
+			t.Errorf("%s odds sum to %v, want %v", sum.name, sum.got, sum.want)
+		}
+	}
+}
+
+func TestRunCancelled(t *testing.T) {
+	teams := league(80, 79, 75, 78, 78, 70)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := Run(ctx, teams, roundRobin(teams), Options{Iterations: 1000}); err == nil {
+		t.Error("Run() with a cancelled context returned no error")
+	}
+}
+
+func TestGamesFromSchedule(t *testing.T) {
+	game := func(pk, away, home int, state string) string {
+		return fmt.Sprintf(`{"gamePk": %d, "status": {"detailedState": %q}, "teams": {"away": {"team": {"id": %d}}, "home": {"team": {"id": %d}}}}`,
+			pk, state, away, home)
+	}
+	day := func(date string, games ...string) string {
+		return fmt.Sprintf(`{"date": %q, "games": [%s]}`, date, strings.Join(games, ", "))
+	}
+
+	tests := []struct {
+		name  string
+		dates []string
+		want  []Game
+	}{
+		{"unplayed", []string{
+			day("2024-09-28", game(1, 10, 20, "Scheduled"), game(2, 30, 40, "Pre-Game")),
+		}, []Game{{Home: 20, Away: 10}, {Home: 40, Away: 30}}},
+		{"over or called off", []string{
+			day("2024-09-28", game(1, 10, 20, "Final"), game(2, 30, 40, "Postponed"), game(3, 20, 10, "Cancelled")),
+			day("2024-09-29", game(4, 40, 30, "Scheduled")),
+		}, []Game{{Home: 30, Away: 40}}},
+		// A suspended game is listed on its original and resumption dates
+		{"suspended", []string{
+			day("2024-09-28", game(5, 10, 20, "Suspended: Rain")),
+			day("2024-09-29", game(5, 10, 20, "Scheduled"), game(6, 10, 20, "Scheduled")),
+		}, []Game{{Home: 20, Away: 10}, {Home: 20, Away: 10}}},
+		{"empty", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var schedule models.ScheduleResponse
+			data := `{"dates": [` + strings.Join(tt.dates, ", ") + `]}`
+			if err := json.Unmarshal([]byte(data), &schedule); err != nil {
+				t.Fatal(err)
+			}
+			if got := GamesFromSchedule(&schedule); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GamesFromSchedule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLog5(t *testing.T) {
+	tests := []struct {
+		a, b, want float64
+	}{
+		{0.5, 0.5, 0.5},
+		{0.6, 0.4, 0.6923},
+		{0.4, 0.6, 0.3077},
+		{1, 1, 0.5},
+		{0, 0.5, 0},
+	}
+
+	for _, tt := range tests {
+		if got := log5(tt.a, tt.b); math.Abs(got-tt.want) > 1e-4 {
+			t.Errorf("log5(%v, %v) = %.4f, want %.4f", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestPythagorean(t *testing.T) {
+	tests := []struct {
+		scored, allowed int
+		want            float64
+	}{
+		{0, 0, 0.5},
+		{700, 700, 0.5},
+		{800, 650, 0.5939},
+		{0, 100, 0},
+	}
+
+	for _, tt := range tests {
+		if got := pythagorean(tt.scored, tt.allowed); math.Abs(got-tt.want) > 1e-4 {
+			t.Errorf("pythagorean(%d, %d) = %.4f, want %.4f", tt.scored, tt.allowed, got, tt.want)
+		}
+	}
+}
@@ -1,12 +1,17 @@
 package tui
 
 import (
+	"context"
+	"time"
+
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 
-	"mlb-cli/internal/api"
-	"mlb-cli/internal/models"
+	"github.com/sgracia13/mlb-cli/internal/clinch"
+	"github.com/sgracia13/mlb-cli/internal/sabermetrics"
+	"github.com/sgracia13/mlb-cli/pkg/api"
+	"github.com/sgracia13/mlb-cli/pkg/models"
 )
 
 // View represents the current view/screen
@@ -32,7 +37,13 @@ const (
 // Model is the main TUI model
 type Model struct {
 	// API client
-	client *api.Client
+	client api.DataSource
+
+	// Time zone for the schedule date and game times
+	location *time.Location
+
+	// wOBA weights and FIP constants for the player screen
+	constants sabermetrics.Table
 
 	// Current view state
 	currentView View
@@ -41,12 +52,16 @@ type Model struct {
 	// Navigation history for back functionality
 	history []View
 
+	// Cancels the in-flight load when the user navigates away
+	cancel context.CancelFunc
+
 	// Data
 	teams       []models.Team
 	roster      []models.RosterEntry
 	player      *models.Player
 	playerStats *models.PlayerStatsResponse
 	standings   *models.StandingsResponse
+	clinch      map[int]*clinch.Status // by team ID, computed when standings load
 	schedule    *models.ScheduleResponse
 
 	// Selected items
@@ -71,8 +86,9 @@ type Model struct {
 	height int
 }
 
-// NewModel creates a new TUI model
-func NewModel() Model {
+// NewModel creates a new TUI model that loads data from client, shows
+// times in loc and scales advanced player metrics by constants
+func NewModel(client api.DataSource, loc *time.Location, constants sabermetrics.Table) Model {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = SpinnerStyle
@@ -83,7 +99,9 @@ func NewModel() Model {
 	ti.Width = 30
 
 	return Model{
-		client:      api.NewClient(),
+		client:      client,
+		location:    loc,
+		constants:   constants,
 		currentView: ViewTeams,
 		currentTab:  TabTeams,
 		history:     make([]View, 0),
@@ -97,85 +115,139 @@ func NewModel() Model {
 	}
 }
 
-// Init initializes the model
+// Init initializes the model and prefetches every tab in parallel, so
+// switching tabs later is instant. Tab switches that happen while a
+// prefetch is still running share its request inside the API client.
 func (m Model) Init() tea.Cmd {
+	ctx := context.Background()
 	return tea.Batch(
 		m.spinner.Tick,
-		m.loadTeams,
+		m.loadTeams(ctx),
+		m.loadStandings(ctx),
+		m.loadSchedule(ctx),
 	)
 }
 
+// startRequest cancels any in-flight load and returns the context for the
+// next one. Call it whenever the view changes.
+func (m *Model) startRequest() context.Context {
+	m.cancelRequest()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	return ctx
+}
+
+// cancelRequest aborts the in-flight load, if any
+func (m *Model) cancelRequest() {
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+}
+
+// request records the context a message was loaded under
+type request struct {
+	ctx context.Context
+}
+
+// stale reports whether the user navigated away while the load was running,
+// in which case its result must not overwrite the current view
+func (r request) stale() bool {
+	return r.ctx.Err() != nil
+}
+
 // Messages for async operations
 type teamsLoadedMsg struct {
+	request
 	teams []models.Team
 	err   error
 }
 
 type rosterLoadedMsg struct {
+	request
 	roster []models.RosterEntry
 	err    error
 }
 
 type standingsLoadedMsg struct {
+	request
 	standings *models.StandingsResponse
+	clinch    map[int]*clinch.Status
 	err       error
 }
 
 type scheduleLoadedMsg struct {
+	request
 	schedule *models.ScheduleResponse
 	err      error
 }
 
 type playerStatsLoadedMsg struct {
+	request
 	stats *models.PlayerStatsResponse
 	err   error
 }
 
 // Command functions
-func (m Model) loadTeams() tea.Msg {
-	resp, err := m.client.GetTeams()
-	if err != nil {
-		return teamsLoadedMsg{err: err}
+func (m Model) loadTeams(ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := m.client.GetTeams(ctx)
+		if err != nil {
+			return teamsLoadedMsg{request: request{ctx}, err: err}
+		}
+		return teamsLoadedMsg{request: request{ctx}, teams: resp.Teams}
 	}
-	return teamsLoadedMsg{teams: resp.Teams}
 }
 
-func (m Model) loadRoster(teamID string) tea.Cmd {
+func (m Model) loadRoster(ctx context.Context, teamID string) tea.Cmd {
 	return func() tea.Msg {
-		resp, err := m.client.GetRoster(teamID)
+		resp, err := m.client.GetRoster(ctx, teamID)
 		if err != nil {
-			return rosterLoadedMsg{err: err}
+			return rosterLoadedMsg{request: request{ctx}, err: err}
 		}
-		return rosterLoadedMsg{roster: resp.Roster}
+		return rosterLoadedMsg{request: request{ctx}, roster: resp.Roster}
 	}
 }
 
-func (m Model) loadStandings() tea.Cmd {
+// loadStandings fetches the current standings along with the games left
+// to play, and computes the magic and elimination numbers once for every
+// render
+func (m Model) loadStandings(ctx context.Context) tea.Cmd {
 	return func() tea.Msg {
-		resp, err := m.client.GetStandings("2024")
+		now := time.Now().In(m.location)
+		query := api.SeasonStandings(now.Format("2006"))
+		resp, err := m.client.GetStandings(ctx, query)
 		if err != nil {
-			return standingsLoadedMsg{err: err}
+			return standingsLoadedMsg{request: request{ctx}, err: err}
+		}
+
+		// The numbers are an extra column, so a failed schedule request
+		// leaves it blank rather than hiding the standings
+		var statuses map[int]*clinch.Status
+		if remaining, err := clinch.GamesRemaining(ctx, m.client, query, now.Format("2006-01-02")); err == nil {
+			statuses = clinch.Compute(resp, nil, remaining, api.WildCardSpots)
 		}
-		return standingsLoadedMsg{standings: resp}
+		return standingsLoadedMsg{request: request{ctx}, standings: resp, clinch: statuses}
 	}
 }
 
-func (m Model) loadSchedule() tea.Cmd {
+func (m Model) loadSchedule(ctx context.Context) tea.Cmd {
 	return func() tea.Msg {
-		resp, err := m.client.GetSchedule("")
+		today := time.Now().In(m.location).Format("2006-01-02")
+		resp, err := api.LocalSchedule(ctx, m.client, api.ScheduleOn(today), m.location)
 		if err != nil {
-			return scheduleLoadedMsg{err: err}
+			return scheduleLoadedMsg{request: request{ctx}, err: err}
 		}
-		return scheduleLoadedMsg{schedule: resp}
+		return scheduleLoadedMsg{request: request{ctx}, schedule: resp}
 	}
 }
 
-func (m Model) loadPlayerStats(playerID string) tea.Cmd {
+func (m Model) loadPlayerStats(ctx context.Context, playerID string) tea.Cmd {
 	return func() tea.Msg {
-		resp, err := m.client.GetPlayerStats(playerID)
+		resp, err := m.client.GetPlayerStats(ctx, playerID)
 		if err != nil {
-			return playerStatsLoadedMsg{err: err}
+			return playerStatsLoadedMsg{request: request{ctx}, err: err}
 		}
-		return playerStatsLoadedMsg{stats: resp}
+		return playerStatsLoadedMsg{request: request{ctx}, stats: resp}
 	}
 }
@@ -1,15 +1,23 @@
 package tui
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/sgracia13/mlb-cli/internal/sabermetrics"
+	"github.com/sgracia13/mlb-cli/pkg/api"
 )
 
-// Run starts the interactive TUI
-func Run() error {
+// Run starts the interactive TUI backed by the given data source, showing
+// times in loc and scaling advanced player metrics by constants. The TUI
+// exits when ctx is cancelled.
+func Run(ctx context.Context, client api.DataSource, loc *time.Location, constants sabermetrics.Table) error {
 	p := tea.NewProgram(
-		NewModel(),
+		NewModel(client, loc, constants),
+		tea.WithContext(ctx),
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
 	)
@@ -36,15 +36,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case teamsLoadedMsg:
-		m.loading = false
-		if msg.err != nil {
-			m.err = msg.err
-		} else {
+		if msg.stale() {
+			break
+		}
+		if msg.err == nil {
 			m.teams = msg.teams
+		}
+		if m.currentView == ViewTeams {
+			m.finishLoad(msg.err)
 			m.resetFilter()
 		}
 
 	case rosterLoadedMsg:
+		if msg.stale() {
+			break
+		}
 		m.loading = false
 		if msg.err != nil {
 			m.err = msg.err
@@ -54,22 +60,32 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case standingsLoadedMsg:
-		m.loading = false
-		if msg.err != nil {
-			m.err = msg.err
-		} else {
+		if msg.stale() {
+			break
+		}
+		if msg.err == nil {
 			m.standings = msg.standings
+			m.clinch = msg.clinch
+		}
+		if m.currentView == ViewStandings {
+			m.finishLoad(msg.err)
 		}
 
 	case scheduleLoadedMsg:
-		m.loading = false
-		if msg.err != nil {
-			m.err = msg.err
-		} else {
+		if msg.stale() {
+			break
+		}
+		if msg.err == nil {
 			m.schedule = msg.schedule
 		}
+		if m.currentView == ViewSchedule {
+			m.finishLoad(msg.err)
+		}
 
 	case playerStatsLoadedMsg:
+		if msg.stale() {
+			break
+		}
 		m.loading = false
 		if msg.err != nil {
 			m.err = msg.err
@@ -84,6 +100,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m Model) handleKeyInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch {
 	case matchKey(msg, m.keys.Quit):
+		m.cancelRequest()
 		return m, tea.Quit
 
 	case matchKey(msg, m.keys.Up):
@@ -195,9 +212,10 @@ func (m Model) handleSelect() (tea.Model, tea.Cmd) {
 				m.cursor = 0
 				m.loading = true
 				m.resetFilter()
+				ctx := m.startRequest()
 				return m, tea.Batch(
 					m.spinner.Tick,
-					m.loadRoster(fmt.Sprintf("%d", m.selectedTeam.ID)),
+					m.loadRoster(ctx, fmt.Sprintf("%d", m.selectedTeam.ID)),
 				)
 			}
 		}
@@ -212,9 +230,10 @@ func (m Model) handleSelect() (tea.Model, tea.Cmd) {
 				m.currentView = ViewPlayer
 				m.cursor = 0
 				m.loading = true
+				ctx := m.startRequest()
 				return m, tea.Batch(
 					m.spinner.Tick,
-					m.loadPlayerStats(fmt.Sprintf("%d", m.selectedPlayer.Person.ID)),
+					m.loadPlayerStats(ctx, fmt.Sprintf("%d", m.selectedPlayer.Person.ID)),
 				)
 			}
 		}
@@ -225,6 +244,9 @@ func (m Model) handleSelect() (tea.Model, tea.Cmd) {
 
 func (m Model) handleBack() (tea.Model, tea.Cmd) {
 	if len(m.history) > 0 {
+		// Abandon whatever the view we are leaving was loading
+		m.cancelRequest()
+		m.loading = false
 		m.currentView = m.history[len(m.history)-1]
 		m.history = m.history[:len(m.history)-1]
 		m.cursor = 0
@@ -244,6 +266,8 @@ func (m Model) prevTab() (tea.Model, tea.Cmd) {
 }
 
 func (m Model) switchToTab() (tea.Model, tea.Cmd) {
+	m.cancelRequest()
+	m.loading = false
 	m.cursor = 0
 	m.history = make([]View, 0)
 	m.resetFilter()
@@ -253,19 +277,22 @@ func (m Model) switchToTab() (tea.Model, tea.Cmd) {
 		m.currentView = ViewTeams
 		if len(m.teams) == 0 {
 			m.loading = true
-			return m, tea.Batch(m.spinner.Tick, m.loadTeams)
+			ctx := m.startRequest()
+			return m, tea.Batch(m.spinner.Tick, m.loadTeams(ctx))
 		}
 	case TabStandings:
 		m.currentView = ViewStandings
 		if m.standings == nil {
 			m.loading = true
-			return m, tea.Batch(m.spinner.Tick, m.loadStandings())
+			ctx := m.startRequest()
+			return m, tea.Batch(m.spinner.Tick, m.loadStandings(ctx))
 		}
 	case TabSchedule:
 		m.currentView = ViewSchedule
 		if m.schedule == nil {
 			m.loading = true
-			return m, tea.Batch(m.spinner.Tick, m.loadSchedule())
+			ctx := m.startRequest()
+			return m, tea.Batch(m.spinner.Tick, m.loadSchedule(ctx))
 		}
 	}
 
@@ -278,24 +305,36 @@ func (m Model) refresh() (tea.Model, tea.Cmd) {
 
 	switch m.currentView {
 	case ViewTeams:
-		return m, tea.Batch(m.spinner.Tick, m.loadTeams)
+		ctx := m.startRequest()
+		return m, tea.Batch(m.spinner.Tick, m.loadTeams(ctx))
 	case ViewRoster:
 		if m.selectedTeam != nil {
-			return m, tea.Batch(m.spinner.Tick, m.loadRoster(fmt.Sprintf("%d", m.selectedTeam.ID)))
+			ctx := m.startRequest()
+			return m, tea.Batch(m.spinner.Tick, m.loadRoster(ctx, fmt.Sprintf("%d", m.selectedTeam.ID)))
 		}
 	case ViewPlayer:
 		if m.selectedPlayer != nil {
-			return m, tea.Batch(m.spinner.Tick, m.loadPlayerStats(fmt.Sprintf("%d", m.selectedPlayer.Person.ID)))
+			ctx := m.startRequest()
+			return m, tea.Batch(m.spinner.Tick, m.loadPlayerStats(ctx, fmt.Sprintf("%d", m.selectedPlayer.Person.ID)))
 		}
 	case ViewStandings:
-		return m, tea.Batch(m.spinner.Tick, m.loadStandings())
+		ctx := m.startRequest()
+		return m, tea.Batch(m.spinner.Tick, m.loadStandings(ctx))
 	case ViewSchedule:
-		return m, tea.Batch(m.spinner.Tick, m.loadSchedule())
+		ctx := m.startRequest()
+		return m, tea.Batch(m.spinner.Tick, m.loadSchedule(ctx))
 	}
 
 	return m, nil
 }
 
+// finishLoad ends the loading state of the current view. Prefetched data
+// for other tabs is stored silently; only the visible view shows errors.
+func (m *Model) finishLoad(err error) {
+	m.loading = false
+	m.err = err
+}
+
 func (m *Model) pushHistory() {
 	m.history = append(m.history, m.currentView)
 }
@@ -5,6 +5,10 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/sgracia13/mlb-cli/internal/clinch"
+	"github.com/sgracia13/mlb-cli/internal/sabermetrics"
+	"github.com/sgracia13/mlb-cli/pkg/models"
 )
 
 // View renders the current view
@@ -217,8 +221,8 @@ func (m Model) renderPlayer() string {
 		// Consolidate stats by group type (hitting/pitching)
 		type consolidatedStats struct {
 			recentSeason string
-			recentStat   map[string]interface{}
-			careerStat   map[string]interface{}
+			recentStat   *models.StatSplit
+			careerStat   *models.StatSplit
 		}
 		statsByGroup := make(map[string]*consolidatedStats)
 
@@ -236,19 +240,19 @@ func (m Model) renderPlayer() string {
 				split := &statGroup.Splits[i]
 				if split.Season == "" {
 					// Career stats
-					statsByGroup[groupName].careerStat = split.Stat
+					statsByGroup[groupName].careerStat = split
 				} else {
 					// Season stats - keep the most recent
 					if statsByGroup[groupName].recentSeason == "" || split.Season > statsByGroup[groupName].recentSeason {
 						statsByGroup[groupName].recentSeason = split.Season
-						statsByGroup[groupName].recentStat = split.Stat
+						statsByGroup[groupName].recentStat = split
 					}
 				}
 			}
 		}
 
 		// Display consolidated stats in order: hitting first, then pitching
-		for _, groupName := range []string{"hitting", "pitching"} {
+		for _, groupName := range []string{models.GroupHitting, models.GroupPitching} {
 			stats, ok := statsByGroup[groupName]
 			if !ok || (stats.recentStat == nil && stats.careerStat == nil) {
 				continue
@@ -258,12 +262,12 @@ func (m Model) renderPlayer() string {
 
 			if stats.recentStat != nil {
 				sb.WriteString(fmt.Sprintf("\n  %s Season:\n", stats.recentSeason))
-				sb.WriteString(m.formatStats(groupName, stats.recentStat))
+				sb.WriteString(m.formatStats(stats.recentStat))
 			}
 
 			if stats.careerStat != nil {
 				sb.WriteString("\n  Career:\n")
-				sb.WriteString(m.formatStats(groupName, stats.careerStat))
+				sb.WriteString(m.formatStats(stats.careerStat))
 			}
 
 			sb.WriteString("\n")
@@ -275,37 +279,48 @@ func (m Model) renderPlayer() string {
 	return sb.String()
 }
 
-func (m Model) formatStats(groupName string, stat map[string]interface{}) string {
+func (m Model) formatStats(split *models.StatSplit) string {
 	var sb strings.Builder
 
-	if groupName == "hitting" {
+	if h := split.Hitting; h != nil {
 		sb.WriteString(fmt.Sprintf("    AVG: %-8v  HR: %-6v  RBI: %-6v\n",
-			getStatValue(stat, "avg"), getStatValue(stat, "homeRuns"), getStatValue(stat, "rbi")))
+			statValue(h.Avg), h.HomeRuns, h.RBI))
 		sb.WriteString(fmt.Sprintf("    H:   %-8v  AB: %-6v  R:   %-6v\n",
-			getStatValue(stat, "hits"), getStatValue(stat, "atBats"), getStatValue(stat, "runs")))
+			h.Hits, h.AtBats, h.Runs))
 		sb.WriteString(fmt.Sprintf("    OBP: %-8v  SLG: %-6v  OPS: %-6v\n",
-			getStatValue(stat, "obp"), getStatValue(stat, "slg"), getStatValue(stat, "ops")))
+			statValue(h.OBP), statValue(h.SLG), statValue(h.OPS)))
 		sb.WriteString(fmt.Sprintf("    SB:  %-8v  BB: %-6v  SO:  %-6v\n",
-			getStatValue(stat, "stolenBases"), getStatValue(stat, "baseOnBalls"), getStatValue(stat, "strikeOuts")))
-	} else if groupName == "pitching" {
+			h.StolenBases, h.BaseOnBalls, h.StrikeOuts))
+
+		adv := sabermetrics.ComputeHitting(h, m.constants.For(split.Season))
+		sb.WriteString(fmt.Sprintf("    wOBA: %-7v  ISO: %-5v  BABIP: %-6v\n",
+			sabermetrics.Average(adv.WOBA), sabermetrics.Average(adv.ISO), sabermetrics.Average(adv.BABIP)))
+		sb.WriteString(fmt.Sprintf("    K%%:   %-7v  BB%%: %-6v\n",
+			sabermetrics.Percent(adv.KPct), sabermetrics.Percent(adv.BBPct)))
+	} else if p := split.Pitching; p != nil {
 		sb.WriteString(fmt.Sprintf("    ERA:  %-8v  W: %-6v  L:   %-6v\n",
-			getStatValue(stat, "era"), getStatValue(stat, "wins"), getStatValue(stat, "losses")))
+			statValue(p.ERA), p.Wins, p.Losses))
 		sb.WriteString(fmt.Sprintf("    G:    %-8v  GS: %-6v  SV:  %-6v\n",
-			getStatValue(stat, "gamesPlayed"), getStatValue(stat, "gamesStarted"), getStatValue(stat, "saves")))
+			p.GamesPlayed, p.GamesStarted, p.Saves))
 		sb.WriteString(fmt.Sprintf("    IP:   %-8v  SO: %-6v  BB:  %-6v\n",
-			getStatValue(stat, "inningsPitched"), getStatValue(stat, "strikeOuts"), getStatValue(stat, "baseOnBalls")))
+			statValue(p.InningsPitched), p.StrikeOuts, p.BaseOnBalls))
 		sb.WriteString(fmt.Sprintf("    WHIP: %-8v  K/9: %-6v  BB/9: %-6v\n",
-			getStatValue(stat, "whip"), getStatValue(stat, "strikeoutsPer9Inn"), getStatValue(stat, "walksPer9Inn")))
+			statValue(p.WHIP), statValue(p.StrikeoutsPer9Inn), statValue(p.WalksPer9Inn)))
+
+		adv := sabermetrics.ComputePitching(p, m.constants.For(split.Season))
+		sb.WriteString(fmt.Sprintf("    FIP:  %-8v  K%%: %-6v  BB%%:  %-6v\n",
+			sabermetrics.ERA(adv.FIP), sabermetrics.Percent(adv.KPct), sabermetrics.Percent(adv.BBPct)))
 	}
 
 	return sb.String()
 }
 
-func getStatValue(stat map[string]interface{}, key string) string {
-	if val, ok := stat[key]; ok {
-		return fmt.Sprintf("%v", val)
+// statValue returns a rate stat, or "-" when the API left it out
+func statValue(val string) string {
+	if val == "" {
+		return "-"
 	}
-	return "-"
+	return val
 }
 
 func (m Model) renderStandings() string {
@@ -315,10 +330,17 @@ func (m Model) renderStandings() string {
 
 	var sb strings.Builder
 
+	// Run and split records only fit on a wide terminal
+	wide := m.width >= standingsWideWidth
+
 	for _, record := range m.standings.Records {
 		sb.WriteString(HeaderStyle.Render(record.Division.Name) + "\n")
 
-		header := fmt.Sprintf("%-3s %-22s %4s %4s %7s %6s", "#", "TEAM", "W", "L", "PCT", "GB")
+		header := fmt.Sprintf("%-3s %-22s %4s %4s %7s %6s %4s", "#", "TEAM", "W", "L", "PCT", "GB", "M/E")
+		if wide {
+			header += fmt.Sprintf(" %5s %5s %5s %6s %6s %5s %6s %5s %6s",
+				"RS", "RA", "DIFF", "HOME", "AWAY", "L10", "DIV", "XTRA", "1-RUN")
+		}
 		sb.WriteString(TableHeaderStyle.Render(header) + "\n")
 
 		for _, tr := range record.TeamRecords {
@@ -326,8 +348,15 @@ func (m Model) renderStandings() string {
 			if gb == "-" {
 				gb = "—"
 			}
-			line := fmt.Sprintf("%-3s %-22s %4d %4d %7s %6s",
-				tr.DivisionRank, tr.Team.Name, tr.Wins, tr.Losses, tr.WinningPct, gb)
+			line := fmt.Sprintf("%-3s %-22s %4d %4d %7s %6s %4s",
+				tr.DivisionRank, tr.Team.Name, tr.Wins, tr.Losses, tr.WinningPct, gb, clinchColumn(m.clinch[tr.Team.ID]))
+			if wide {
+				line += fmt.Sprintf(" %5d %5d %+5d %6s %6s %5s %6s %5s %6s",
+					tr.RunsScored, tr.RunsAllowed, tr.RunDifferential,
+					tr.SplitRecord(models.SplitHome), tr.SplitRecord(models.SplitAway),
+					tr.SplitRecord(models.SplitLastTen), tr.DivisionRecord(record.Division.ID),
+					tr.SplitRecord(models.SplitExtraInning), tr.SplitRecord(models.SplitOneRun))
+			}
 			sb.WriteString(NormalStyle.Render(line) + "\n")
 		}
 		sb.WriteString("\n")
@@ -336,6 +365,29 @@ func (m Model) renderStandings() string {
 	return sb.String()
 }
 
+// standingsWideWidth is the terminal width needed for the extra
+// standings columns
+const standingsWideWidth = 120
+
+// clinchColumn shows a division leader's magic number ("M5") or a trailing
+// team's elimination number ("E3"), with ✓ once clinched and ✗ once
+// eliminated
+func clinchColumn(s *clinch.Status) string {
+	switch {
+	case s == nil:
+		return "-"
+	case s.ClinchedDivision:
+		return "✓"
+	case s.EliminatedDivision:
+		return "✗"
+	case s.DivisionMagic != nil:
+		return fmt.Sprintf("M%d", *s.DivisionMagic)
+	case s.DivisionElimination != nil:
+		return fmt.Sprintf("E%d", *s.DivisionElimination)
+	}
+	return "-"
+}
+
 func (m Model) renderSchedule() string {
 	if m.schedule == nil || len(m.schedule.Dates) == 0 {
 		return MutedStyle.Render("No games scheduled")
@@ -349,15 +401,22 @@ func (m Model) renderSchedule() string {
 		for _, game := range date.Games {
 			status := game.Status.DetailedState
 			matchup := fmt.Sprintf("%s @ %s", game.Teams.Away.Team.Name, game.Teams.Home.Team.Name)
+			if game.IsDoubleHeader() {
+				matchup += fmt.Sprintf(" (Gm %d)", game.GameNumber)
+			}
 
 			var line string
 			if status == "Final" || status == "Game Over" {
 				line = fmt.Sprintf("%-40s  %d - %d  [%s]",
 					matchup, game.Teams.Away.Score, game.Teams.Home.Score, status)
 			} else {
-				line = fmt.Sprintf("%-40s  [%s]", matchup, status)
+				line = fmt.Sprintf("%-40s  %s  [%s]", matchup, game.LocalStartTime(m.location), status)
 			}
 			sb.WriteString(NormalStyle.Render(line) + "\n")
+
+			if details := scheduleDetails(game); details != "" {
+				sb.WriteString(MutedStyle.Render("    "+details) + "\n")
+			}
 		}
 		sb.WriteString("\n")
 	}
@@ -365,6 +424,19 @@ func (m Model) renderSchedule() string {
 	return sb.String()
 }
 
+// scheduleDetails lists the pitchers of record, or the probable starters,
+// and the series status for a game
+func scheduleDetails(game models.ScheduleGame) string {
+	var parts []string
+	if pitchers := game.Pitchers(); pitchers != "" {
+		parts = append(parts, pitchers)
+	}
+	if series := game.Series(); series != "" {
+		parts = append(parts, series)
+	}
+	return strings.Join(parts, "  •  ")
+}
+
 func (m Model) renderFilter() string {
 	if !m.filterMode && m.filterText == "" {
 		return ""
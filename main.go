@@ -1,6 +1,6 @@
 package main
 
-import "mlb-cli/cmd"
+import "github.com/sgracia13/mlb-cli/cmd"
 
 func main() {
 	cmd.Execute()
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CacheForever marks responses that never expire, such as finished seasons
+const CacheForever time.Duration = -1
+
+// LiveFeedTTL is how long a live game feed stays cached. Polling a game
+// more often than this only redraws the same snapshot.
+const LiveFeedTTL = 5 * time.Second
+
+// Cache stores raw API responses on disk, keyed by request URL
+type Cache struct {
+	dir string
+	ttl time.Duration // overrides the per-endpoint TTL when > 0
+}
+
+// NewCache creates a cache rooted at dir. A ttl > 0 applies to every
+// endpoint; otherwise each endpoint uses EndpointTTL.
+func NewCache(dir string, ttl time.Duration) *Cache {
+	return &Cache{dir: dir, ttl: ttl}
+}
+
+// DefaultCacheDir returns the cache directory under the user cache dir
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "mlb-cli"), nil
+}
+
+// Get returns the cached body for rawURL if it exists and has not expired
+func (c *Cache) Get(rawURL string) ([]byte, bool) {
+	ttl := c.ttlFor(rawURL)
+	if ttl == 0 {
+		return nil, false
+	}
+
+	path := c.path(rawURL)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false
+	}
+	if ttl != CacheForever && time.Since(info.ModTime()) > ttl {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Put stores the body for rawURL, replacing any previous entry
+func (c *Cache) Put(rawURL string, data []byte) error {
+	if c.ttlFor(rawURL) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return err
+	}
+
+	// Write to a temp file first so concurrent readers never see a partial entry
+	tmp, err := os.CreateTemp(c.dir, "entry-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path(rawURL))
+}
+
+func (c *Cache) ttlFor(rawURL string) time.Duration {
+	if c.ttl > 0 {
+		return c.ttl
+	}
+	return EndpointTTL(rawURL, time.Now())
+}
+
+func (c *Cache) path(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// EndpointTTL returns how long a response for rawURL stays fresh as of now.
+// Data for past dates and seasons never changes, so it is kept forever;
+// anything that can change during a game is only kept for seconds.
+func EndpointTTL(rawURL string, now time.Time) time.Duration {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 0
+	}
+	path := u.Path
+	query := u.Query()
+
+	switch {
+	case strings.HasSuffix(path, "/feed/live"):
+		return LiveFeedTTL
+	case strings.HasSuffix(path, "/schedule"):
+		date := query.Get("date")
+		if end := query.Get("endDate"); end != "" {
+			date = end
+		}
+		if isPastDate(date, now) {
+			return CacheForever
+		}
+		return 30 * time.Second
+	case strings.HasSuffix(path, "/standings"):
+		if date := query.Get("date"); date != "" {
+			if isPastDate(date, now) {
+				return CacheForever
+			}
+		} else if isPastSeason(query.Get("season"), now) {
+			return CacheForever
+		}
+		return 10 * time.Minute
+	case strings.HasSuffix(path, "/roster"):
+		return 6 * time.Hour
+	case strings.HasSuffix(path, "/teams"):
+		return 24 * time.Hour
+	case strings.HasSuffix(path, "/people/search"):
+		return 24 * time.Hour
+	case strings.Contains(path, "/people/") && strings.HasSuffix(path, "/stats"):
+		if isPastSeason(query.Get("season"), now) {
+			return CacheForever
+		}
+		return 10 * time.Minute
+	case strings.Contains(path, "/people/"):
+		return time.Hour
+	}
+	return 5 * time.Minute
+}
+
+// isPastDate reports whether a YYYY-MM-DD date is safely in the past. A
+// full day of slack covers late games finishing in other time zones.
+func isPastDate(date string, now time.Time) bool {
+	d, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return false
+	}
+	return d.Before(now.AddDate(0, 0, -1))
+}
+
+// isPastSeason reports whether a season year is before the current year
+func isPastSeason(season string, now time.Time) bool {
+	year, err := strconv.Atoi(season)
+	if err != nil {
+		return false
+	}
+	return year < now.Year()
+}
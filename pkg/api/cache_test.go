@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEndpointTTL(t *testing.T) {
+	now := time.Date(2024, 7, 4, 18, 0, 0, 0, time.UTC)
+	base := DefaultBaseURL
+
+	tests := []struct {
+		name string
+		url  string
+		want time.Duration
+	}{
+		{"live feed", "https://statsapi.mlb.com/api/v1.1/game/745444/feed/live", 5 * time.Second},
+		{"today's schedule", base + "/schedule?sportId=1&date=2024-07-04", 30 * time.Second},
+		{"past schedule", base + "/schedule?sportId=1&date=2024-07-01", CacheForever},
+		{"range ending today", base + "/schedule?startDate=2024-06-01&endDate=2024-07-04", 30 * time.Second},
+		{"past range", base + "/schedule?startDate=2024-06-01&endDate=2024-06-30", CacheForever},
+		{"today's games", base + "/schedule?sportId=1", 30 * time.Second},
+		{"current standings", base + "/standings?leagueId=103,104&season=2024", 10 * time.Minute},
+		{"past season standings", base + "/standings?leagueId=103,104&season=2023", CacheForever},
+		{"standings on a past date", base + "/standings?season=2024&date=2024-06-01", CacheForever},
+		{"standings today", base + "/standings?season=2024&date=2024-07-04", 10 * time.Minute},
+		{"roster", base + "/teams/147/roster", 6 * time.Hour},
+		{"teams", base + "/teams?sportId=1", 24 * time.Hour},
+		{"player search", base + "/people/search?names=judge", 24 * time.Hour},
+		{"current game log", base + "/people/592450/stats?stats=gameLog&season=2024", 10 * time.Minute},
+		{"past splits", base + "/people/592450/stats?stats=statSplits&season=2023", CacheForever},
+		{"career matchup", base + "/people/592450/stats?stats=vsPlayer&opposingPlayerId=519242", 10 * time.Minute},
+		{"player", base + "/people/592450?hydrate=stats", time.Hour},
+		{"other", base + "/venues", 5 * time.Minute},
+		{"unparsable", "http://[::1", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EndpointTTL(tt.url, now); got != tt.want {
+				t.Errorf("EndpointTTL(%q) = %v, want %v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
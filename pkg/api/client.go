@@ -0,0 +1,431 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// Defaults used by NewClient
+const (
+	DefaultBaseURL   = "https://statsapi.mlb.com/api/v1"
+	DefaultTimeout   = 10 * time.Second
+	DefaultUserAgent = "mlb-cli"
+)
+
+// Client handles all MLB API interactions
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+	headers    map[string]string
+	cache      *Cache
+	retry      RetryPolicy
+	limiter    *rate.Limiter
+	recorder   *Tape
+	replay     *Tape
+	trace      *tracer
+	inflight   inflight
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient replaces the default HTTP client, e.g. to set a proxy,
+// custom CA bundle or timeout
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithBaseURL points the client at another API root, such as a local mirror
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeaders adds headers to every request
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) {
+		c.headers = headers
+	}
+}
+
+// WithCache enables the on-disk response cache
+func WithCache(cache *Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithRetry sets how failed requests are retried
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithRateLimiter throttles outgoing requests. Share one limiter between
+// clients and goroutines to bound the total request rate.
+func WithRateLimiter(limiter *rate.Limiter) Option {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+// WithRecorder saves every response the client returns to tape
+func WithRecorder(tape *Tape) Option {
+	return func(c *Client) {
+		c.recorder = tape
+	}
+}
+
+// WithReplay serves every response from tape instead of the network
+func WithReplay(tape *Tape) Option {
+	return func(c *Client) {
+		c.replay = tape
+	}
+}
+
+// NewRateLimiter returns a token bucket allowing perSecond requests per
+// second with bursts of the same size
+func NewRateLimiter(perSecond float64) *rate.Limiter {
+	burst := int(perSecond)
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(perSecond), burst)
+}
+
+// NewClient creates a new MLB API client
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		baseURL:    DefaultBaseURL,
+		userAgent:  DefaultUserAgent,
+		retry:      DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.trace.redact(c.headers)
+	return c
+}
+
+// fetch returns the response body for url. Every endpoint goes through
+// here, so replay, recording and request coalescing apply to all of them.
+func (c *Client) fetch(ctx context.Context, url string) ([]byte, error) {
+	request := c.requestKey(url)
+	if c.replay != nil {
+		c.trace.printf(TraceRequests, "GET %s (replayed)", url)
+		data, err := c.replay.Load(request)
+		if legacy, ok := strings.CutPrefix(url, c.baseURL); ok && KindOf(err) == KindNotFound {
+			// Tapes recorded before v1.1 endpoints were added are keyed
+			// without the version, e.g. "/schedule?date=2024-07-04"
+			if data, legacyErr := c.replay.Load(legacy); legacyErr == nil {
+				return data, nil
+			}
+		}
+		return data, err
+	}
+
+	data, err := c.inflight.do(ctx, url, func(ctx context.Context) ([]byte, error) {
+		return c.load(ctx, url)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.recorder != nil {
+		if err := c.recorder.Save(request, data); err != nil {
+			return nil, fmt.Errorf("failed to record response: %w", err)
+		}
+	}
+	return data, nil
+}
+
+// requestKey identifies rawURL independently of the configured host, keeping
+// the API version so v1 and v1.1 endpoints don't collide, e.g.
+// "/v1/schedule?date=2024-07-04". A URL that can't be parsed is its own key.
+func (c *Client) requestKey(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return rawURL
+	}
+
+	// Strip everything above the version segment of the base path
+	root := base.EscapedPath()
+	if i := strings.LastIndex(root, "/"); i >= 0 {
+		root = root[:i]
+	}
+	key := strings.TrimPrefix(u.EscapedPath(), root)
+	if u.RawQuery != "" {
+		key += "?" + u.RawQuery
+	}
+	return key
+}
+
+// v11URL returns the base URL for endpoints that are only served by
+// version 1.1 of the API, such as the live game feed
+func (c *Client) v11URL() string {
+	if strings.HasSuffix(c.baseURL, "/v1") {
+		return c.baseURL + ".1"
+	}
+	return c.baseURL
+}
+
+// load returns the response body for url, from the cache when possible
+func (c *Client) load(ctx context.Context, url string) ([]byte, error) {
+	if c.cache != nil {
+		if data, ok := c.cache.Get(url); ok {
+			c.trace.printf(TraceRequests, "GET %s (cached)", url)
+			return data, nil
+		}
+	}
+
+	data, err := c.getWithRetry(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.cache != nil {
+		// A cache write failure only costs a future request
+		_ = c.cache.Put(url, data)
+	}
+	return data, nil
+}
+
+// getWithRetry calls get, retrying transient failures with backoff
+func (c *Client) getWithRetry(ctx context.Context, url string) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			start := time.Now()
+			if err := c.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			if waited := time.Since(start); waited > time.Millisecond {
+				c.trace.printf(TraceRetries, "rate limiter delayed request by %d ms", waited.Milliseconds())
+			}
+		}
+
+		data, err := c.get(ctx, url)
+		if err == nil {
+			return data, nil
+		}
+		if attempt >= c.retry.MaxRetries || !retryable(err) || ctx.Err() != nil {
+			return nil, err
+		}
+
+		delay := c.retry.backoff(attempt, err)
+		c.trace.printf(TraceRetries, "retry %d/%d for %s in %d ms: %v",
+			attempt+1, c.retry.MaxRetries, url, delay.Milliseconds(), err)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// get performs an HTTP GET request and returns the response body
+func (c *Client) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, &Error{Kind: KindInvalidInput, Err: fmt.Errorf("invalid request: %w", err)}
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.trace.printf(TraceRequests, "GET %s failed in %d ms: %v", url, time.Since(start).Milliseconds(), err)
+		return nil, &Error{Kind: KindNetwork, Err: fmt.Errorf("request failed: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &Error{Kind: KindNetwork, Err: fmt.Errorf("failed to read response: %w", err)}
+	}
+	c.trace.request(req, resp, data, time.Since(start))
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, statusError(resp)
+	}
+	return data, nil
+}
+
+// getJSON fetches url and decodes the JSON response into v
+func (c *Client) getJSON(ctx context.Context, url string, v any) error {
+	data, err := c.fetch(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return &Error{Kind: KindDecode, Err: fmt.Errorf("failed to parse response: %w", err)}
+	}
+	return nil
+}
+
+// GetTeams retrieves all MLB teams
+func (c *Client) GetTeams(ctx context.Context) (*models.TeamsResponse, error) {
+	var resp models.TeamsResponse
+	if err := c.getJSON(ctx, c.baseURL+"/teams?sportId=1", &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetStandings retrieves the standings selected by query
+func (c *Client) GetStandings(ctx context.Context, query StandingsQuery) (*models.StandingsResponse, error) {
+	url := fmt.Sprintf("%s/standings?%s", c.baseURL, query.values().Encode())
+	var resp models.StandingsResponse
+	if err := c.getJSON(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetSchedule retrieves the game schedule for a given date
+func (c *Client) GetSchedule(ctx context.Context, query ScheduleQuery) (*models.ScheduleResponse, error) {
+	url := fmt.Sprintf("%s/schedule?%s", c.baseURL, query.values().Encode())
+	var resp models.ScheduleResponse
+	if err := c.getJSON(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// SearchPlayer searches for a player by name
+func (c *Client) SearchPlayer(ctx context.Context, name string) (*models.PlayerSearchResponse, error) {
+	url := fmt.Sprintf("%s/people/search?names=%s&sportId=1", c.baseURL, strings.ReplaceAll(name, " ", "%20"))
+	var resp models.PlayerSearchResponse
+	if err := c.getJSON(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.People) == 0 {
+		return nil, newError(KindNotFound, "no players found matching %q", name)
+	}
+
+	return &resp, nil
+}
+
+// GetPlayerStats retrieves statistics for a player by ID
+func (c *Client) GetPlayerStats(ctx context.Context, playerID string) (*models.PlayerStatsResponse, error) {
+	statTypes := "yearByYear,career"
+	url := fmt.Sprintf("%s/people/%s?hydrate=stats(group=[hitting,pitching,fielding],type=[%s])", c.baseURL, playerID, statTypes)
+	var resp models.PlayerStatsResponse
+	if err := c.getJSON(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.People) == 0 {
+		return nil, newError(KindNotFound, "no player with ID %s", playerID)
+	}
+
+	return &resp, nil
+}
+
+// GetGameLog retrieves a player's game-by-game hitting and pitching lines
+// for a season
+func (c *Client) GetGameLog(ctx context.Context, playerID, season string) (*models.StatsResponse, error) {
+	url := fmt.Sprintf("%s/people/%s/stats?stats=gameLog&group=hitting,pitching&season=%s", c.baseURL, playerID, season)
+	var resp models.StatsResponse
+	if err := c.getJSON(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetSplits retrieves a player's season stats broken down by situation,
+// such as vs left-handed pitching or at home
+func (c *Client) GetSplits(ctx context.Context, query SplitsQuery) (*models.StatsResponse, error) {
+	url := fmt.Sprintf("%s/people/%s/stats?%s", c.baseURL, query.PlayerID, query.values().Encode())
+	var resp models.StatsResponse
+	if err := c.getJSON(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetMatchup retrieves a batter's history against a pitcher, for their
+// careers and for each season they faced each other
+func (c *Client) GetMatchup(ctx context.Context, batterID, pitcherID string) (*models.StatsResponse, error) {
+	url := fmt.Sprintf("%s/people/%s/stats?stats=vsPlayer&group=hitting&opposingPlayerId=%s", c.baseURL, batterID, pitcherID)
+	var resp models.StatsResponse
+	if err := c.getJSON(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetRoster retrieves the active roster for a team
+func (c *Client) GetRoster(ctx context.Context, teamID string) (*models.RosterResponse, error) {
+	url := fmt.Sprintf("%s/teams/%s/roster?rosterType=active", c.baseURL, teamID)
+	var resp models.RosterResponse
+	if err := c.getJSON(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetGameFeed retrieves the live feed for a game: status, linescore and
+// the current batter/pitcher matchup
+func (c *Client) GetGameFeed(ctx context.Context, gamePk string) (*models.GameFeedResponse, error) {
+	url := fmt.Sprintf("%s/game/%s/feed/live", c.v11URL(), gamePk)
+	var resp models.GameFeedResponse
+	if err := c.getJSON(ctx, url, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ResolveTeamID resolves a team abbreviation or ID to a team ID
+func ResolveTeamID(input string) (string, error) {
+	// First try to parse as int (direct ID)
+	if _, err := strconv.Atoi(input); err == nil {
+		return input, nil
+	}
+
+	// Try to look up by abbreviation
+	upper := strings.ToUpper(input)
+	if id, ok := models.GetTeamID(upper); ok {
+		return strconv.Itoa(id), nil
+	}
+
+	return "", newError(KindInvalidInput, "unknown team: %s (use team abbreviation like LAD, NYY, or team ID)", input)
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequestKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		url     string
+		want    string
+	}{
+		{"default", DefaultBaseURL, DefaultBaseURL + "/schedule?sportId=1&date=2024-07-04", "/v1/schedule?sportId=1&date=2024-07-04"},
+		{"v1.1 feed", DefaultBaseURL, "https://statsapi.mlb.com/api/v1.1/game/745444/feed/live", "/v1.1/game/745444/feed/live"},
+		{"mirror host", "http://localhost:8080/api/v1", "http://localhost:8080/api/v1/teams?sportId=1", "/v1/teams?sportId=1"},
+		{"escaped path", DefaultBaseURL, DefaultBaseURL + "/people/search?names=shohei%20ohtani", "/v1/people/search?names=shohei%20ohtani"},
+		{"base without path", "http://localhost:8080", "http://localhost:8080/teams", "/teams"},
+		{"base without slash", "localhost", "localhost/teams", "/teams"},
+		{"unparsable url", DefaultBaseURL, "http://[::1", "http://[::1"},
+		{"unparsable base", "http://[::1", DefaultBaseURL + "/teams", DefaultBaseURL + "/teams"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(WithBaseURL(tt.baseURL))
+			if got := c.requestKey(tt.url); got != tt.want {
+				t.Errorf("requestKey(%q) = %q, want %q", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientCancel(t *testing.T) {
+	t.Run("in flight", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+		defer srv.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := NewClient(WithBaseURL(srv.URL)).GetTeams(ctx)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("GetTeams() error = %v, want deadline exceeded", err)
+		}
+	})
+
+	t.Run("during backoff", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+		client := NewClient(WithBaseURL(srv.URL), WithRetry(RetryPolicy{MaxRetries: 3, BaseDelay: time.Minute, MaxDelay: time.Minute}))
+
+		start := time.Now()
+		_, err := client.GetTeams(ctx)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("GetTeams() error = %v, want canceled", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("GetTeams() returned after %v, want it to stop waiting on cancel", elapsed)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+// Package api is a Go client for the MLB Stats API (statsapi.mlb.com).
+//
+// It is the same client the mlb CLI and TUI are built on, so anything the
+// CLI can show is available here. Responses decode into the types in the
+// models package.
+//
+//	client := api.NewClient(
+//		api.WithHTTPClient(&http.Client{Timeout: 5 * time.Second}),
+//		api.WithCache(api.NewCache(dir, 0)),
+//	)
+//	standings, err := client.GetStandings(ctx, api.SeasonStandings("2024"))
+//	if errors.Is(err, api.ErrNotFound) {
+//		// ...
+//	}
+//
+// Code that only reads data should accept a DataSource, which lets tests
+// substitute a FixtureClient loaded with canned JSON.
+package api
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrorKind classifies why an API call failed
+type ErrorKind int
+
+const (
+	KindUnknown      ErrorKind = iota
+	KindNotFound               // the requested team, player or game does not exist
+	KindRateLimited            // the API answered 429 Too Many Requests
+	KindUpstream               // the API answered with a 5xx server error
+	KindDecode                 // the response body could not be parsed
+	KindNetwork                // the request never got a response
+	KindInvalidInput           // the caller passed a bad argument
+)
+
+// String returns the snake_case name used in machine-readable output
+func (k ErrorKind) String() string {
+	switch k {
+	case KindNotFound:
+		return "not_found"
+	case KindRateLimited:
+		return "rate_limited"
+	case KindUpstream:
+		return "upstream"
+	case KindDecode:
+		return "decode"
+	case KindNetwork:
+		return "network"
+	case KindInvalidInput:
+		return "invalid_input"
+	}
+	return "unknown"
+}
+
+// Error is returned by every Client and DataSource method. Use errors.Is
+// with the Err* sentinels, or errors.As to inspect the details.
+type Error struct {
+	Kind       ErrorKind
+	StatusCode int           // HTTP status, if the API responded
+	RetryAfter time.Duration // from the Retry-After header, if any
+	Err        error         // underlying cause
+}
+
+// Sentinels for errors.Is; only the Kind is compared
+var (
+	ErrNotFound     = &Error{Kind: KindNotFound}
+	ErrRateLimited  = &Error{Kind: KindRateLimited}
+	ErrUpstream     = &Error{Kind: KindUpstream}
+	ErrDecode       = &Error{Kind: KindDecode}
+	ErrNetwork      = &Error{Kind: KindNetwork}
+	ErrInvalidInput = &Error{Kind: KindInvalidInput}
+)
+
+func (e *Error) Error() string {
+	switch {
+	case e.Err != nil:
+		return e.Err.Error()
+	case e.StatusCode != 0:
+		return fmt.Sprintf("API returned status %d (%s)", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return e.Kind.String()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is an *Error of the same kind
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Kind == e.Kind
+}
+
+// KindOf returns the kind of the first *Error in err's chain
+func KindOf(err error) ErrorKind {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Kind
+	}
+	return KindUnknown
+}
+
+// newError creates an error of the given kind with a formatted message
+func newError(kind ErrorKind, format string, args ...any) *Error {
+	return &Error{Kind: kind, Err: fmt.Errorf(format, args...)}
+}
+
+// InvalidInput creates a KindInvalidInput error with a formatted message,
+// for callers rejecting a bad flag, argument or setting
+func InvalidInput(format string, args ...any) *Error {
+	return newError(KindInvalidInput, format, args...)
+}
+
+// NotFound creates a KindNotFound error with a formatted message
+func NotFound(format string, args ...any) *Error {
+	return newError(KindNotFound, format, args...)
+}
+
+// statusError classifies a non-200 response
+func statusError(resp *http.Response) *Error {
+	kind := KindUnknown
+	switch code := resp.StatusCode; {
+	case code == http.StatusNotFound:
+		kind = KindNotFound
+	case code == http.StatusTooManyRequests:
+		kind = KindRateLimited
+	case code == http.StatusBadRequest:
+		kind = KindInvalidInput
+	case code >= 500:
+		kind = KindUpstream
+	}
+
+	return &Error{
+		Kind:       kind,
+		StatusCode: resp.StatusCode,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
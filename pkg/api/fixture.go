@@ -0,0 +1,237 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// FixtureClient serves canned responses from memory instead of calling the
+// MLB API. Maps are keyed by the argument passed to the matching method;
+// player searches are keyed by FixtureKey(name). A missing fixture is a
+// not found error, so pairs who never faced each other need an explicit
+// empty matchup, {"stats": []}, as the live API returns.
+type FixtureClient struct {
+	Teams     *models.TeamsResponse
+	Standings map[string]*models.StandingsResponse    // by season[-type][-date]
+	Schedules map[string]*models.ScheduleResponse     // by date
+	Players   map[string]*models.PlayerSearchResponse // by FixtureKey(name)
+	Stats     map[string]*models.PlayerStatsResponse  // by player ID
+	GameLogs  map[string]*models.StatsResponse        // by playerID-season
+	Splits    map[string]*models.StatsResponse        // by playerID-season-group
+	Matchups  map[string]*models.StatsResponse        // by batterID-pitcherID
+	Rosters   map[string]*models.RosterResponse       // by team ID
+	Games     map[string]*models.GameFeedResponse     // by gamePk
+}
+
+// NewFixtureClient creates an empty fixture client
+func NewFixtureClient() *FixtureClient {
+	return &FixtureClient{
+		Standings: make(map[string]*models.StandingsResponse),
+		Schedules: make(map[string]*models.ScheduleResponse),
+		Players:   make(map[string]*models.PlayerSearchResponse),
+		Stats:     make(map[string]*models.PlayerStatsResponse),
+		GameLogs:  make(map[string]*models.StatsResponse),
+		Splits:    make(map[string]*models.StatsResponse),
+		Matchups:  make(map[string]*models.StatsResponse),
+		Rosters:   make(map[string]*models.RosterResponse),
+		Games:     make(map[string]*models.GameFeedResponse),
+	}
+}
+
+// LoadFixtures builds a fixture client from raw API JSON laid out as:
+//
+//	teams.json
+//	standings/<season>[-<type>][-<date>].json
+//	schedule/<date>.json
+//	players/<FixtureKey(name)>.json
+//	stats/<playerID>.json
+//	gamelog/<playerID>-<season>.json
+//	splits/<playerID>-<season>-<group>.json
+//	matchup/<batterID>-<pitcherID>.json
+//	roster/<teamID>.json
+//	game/<gamePk>.json
+//
+// Every file is optional.
+func LoadFixtures(fsys fs.FS) (*FixtureClient, error) {
+	f := NewFixtureClient()
+
+	if data, err := fs.ReadFile(fsys, "teams.json"); err == nil {
+		f.Teams = &models.TeamsResponse{}
+		if err := json.Unmarshal(data, f.Teams); err != nil {
+			return nil, fmt.Errorf("failed to parse teams.json: %w", err)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	if err := loadFixtureDir(fsys, "standings", f.Standings); err != nil {
+		return nil, err
+	}
+	if err := loadFixtureDir(fsys, "schedule", f.Schedules); err != nil {
+		return nil, err
+	}
+	if err := loadFixtureDir(fsys, "players", f.Players); err != nil {
+		return nil, err
+	}
+	if err := loadFixtureDir(fsys, "stats", f.Stats); err != nil {
+		return nil, err
+	}
+	if err := loadFixtureDir(fsys, "gamelog", f.GameLogs); err != nil {
+		return nil, err
+	}
+	if err := loadFixtureDir(fsys, "splits", f.Splits); err != nil {
+		return nil, err
+	}
+	if err := loadFixtureDir(fsys, "matchup", f.Matchups); err != nil {
+		return nil, err
+	}
+	if err := loadFixtureDir(fsys, "roster", f.Rosters); err != nil {
+		return nil, err
+	}
+	if err := loadFixtureDir(fsys, "game", f.Games); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// loadFixtureDir decodes every <key>.json file in dir into dst
+func loadFixtureDir[T any](fsys fs.FS, dir string, dst map[string]*T) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".json" {
+			continue
+		}
+		file := path.Join(dir, e.Name())
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+		v := new(T)
+		if err := json.Unmarshal(data, v); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		dst[strings.TrimSuffix(e.Name(), ".json")] = v
+	}
+	return nil
+}
+
+// FixtureKey normalizes a player search name into a fixture key
+func FixtureKey(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+// lookupFixture returns the fixture stored under key or a descriptive error
+func lookupFixture[T any](ctx context.Context, m map[string]*T, kind, key string) (*T, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if v, ok := m[key]; ok {
+		return v, nil
+	}
+	return nil, newError(KindNotFound, "no %s fixture for %q", kind, key)
+}
+
+// GetTeams returns the teams fixture
+func (f *FixtureClient) GetTeams(ctx context.Context) (*models.TeamsResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if f.Teams == nil {
+		return nil, newError(KindNotFound, "no teams fixture")
+	}
+	return f.Teams, nil
+}
+
+// GetStandings returns the standings fixture for a query
+func (f *FixtureClient) GetStandings(ctx context.Context, query StandingsQuery) (*models.StandingsResponse, error) {
+	return lookupFixture(ctx, f.Standings, "standings", query.fixtureKey())
+}
+
+// GetSchedule returns the schedule fixtures for the days in a query,
+// filtered by its team and game types
+func (f *FixtureClient) GetSchedule(ctx context.Context, query ScheduleQuery) (*models.ScheduleResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if query.StartDate == "" {
+		// Like the live API, an empty query is today's games
+		query.StartDate = time.Now().Format("2006-01-02")
+	}
+	resp, ok := scheduleFixture(f.Schedules, query)
+	if !ok {
+		return nil, newError(KindNotFound, "no schedule fixture for %q", query.StartDate)
+	}
+	return resp, nil
+}
+
+// SearchPlayer returns the player search fixture for a name
+func (f *FixtureClient) SearchPlayer(ctx context.Context, name string) (*models.PlayerSearchResponse, error) {
+	return lookupFixture(ctx, f.Players, "player search", FixtureKey(name))
+}
+
+// GetPlayerStats returns the stats fixture for a player ID
+func (f *FixtureClient) GetPlayerStats(ctx context.Context, playerID string) (*models.PlayerStatsResponse, error) {
+	return lookupFixture(ctx, f.Stats, "player stats", playerID)
+}
+
+// GetGameLog returns the game log fixture for a player and season
+func (f *FixtureClient) GetGameLog(ctx context.Context, playerID, season string) (*models.StatsResponse, error) {
+	return lookupFixture(ctx, f.GameLogs, "game log", playerID+"-"+season)
+}
+
+// GetSplits returns the splits fixture for a player, season and group,
+// keeping only the query's sitCodes
+func (f *FixtureClient) GetSplits(ctx context.Context, query SplitsQuery) (*models.StatsResponse, error) {
+	resp, err := lookupFixture(ctx, f.Splits, "splits", query.fixtureKey())
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(query.SitCodes))
+	for _, code := range query.SitCodes {
+		wanted[code] = true
+	}
+	filtered := &models.StatsResponse{}
+	for _, group := range resp.Stats {
+		var splits []models.StatSplit
+		for _, s := range group.Splits {
+			if s.Split != nil && wanted[s.Split.Code] {
+				splits = append(splits, s)
+			}
+		}
+		group.Splits = splits
+		filtered.Stats = append(filtered.Stats, group)
+	}
+	return filtered, nil
+}
+
+// GetMatchup returns the matchup fixture for a batter and pitcher
+func (f *FixtureClient) GetMatchup(ctx context.Context, batterID, pitcherID string) (*models.StatsResponse, error) {
+	return lookupFixture(ctx, f.Matchups, "matchup", batterID+"-"+pitcherID)
+}
+
+// GetRoster returns the roster fixture for a team ID
+func (f *FixtureClient) GetRoster(ctx context.Context, teamID string) (*models.RosterResponse, error) {
+	return lookupFixture(ctx, f.Rosters, "roster", teamID)
+}
+
+// GetGameFeed returns the live feed fixture for a game
+func (f *FixtureClient) GetGameFeed(ctx context.Context, gamePk string) (*models.GameFeedResponse, error) {
+	return lookupFixture(ctx, f.Games, "game feed", gamePk)
+}
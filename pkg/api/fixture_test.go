@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+func TestFixtureScheduleDefaultsToToday(t *testing.T) {
+	today := time.Now().Format("2006-01-02")
+	f := NewFixtureClient()
+	f.Schedules[today] = &models.ScheduleResponse{Dates: []models.ScheduleDate{{
+		Date:  today,
+		Games: []models.ScheduleGame{{GamePk: 1}},
+	}}}
+
+	resp, err := f.GetSchedule(context.Background(), ScheduleQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Dates) != 1 || resp.Dates[0].Date != today {
+		t.Errorf("GetSchedule(empty query) = %+v, want today's games", resp.Dates)
+	}
+
+	if _, err := f.GetSchedule(context.Background(), ScheduleOn("1999-01-01")); KindOf(err) != KindNotFound {
+		t.Errorf("GetSchedule(missing day) error = %v, want not found", err)
+	}
+}
+
+func TestLoadFixtures(t *testing.T) {
+	fsys := fstest.MapFS{
+		"teams.json":                      {Data: []byte(`{"teams": [{"id": 147, "name": "New York Yankees"}]}`)},
+		"standings/2024-wildCard.json":    {Data: []byte(`{"records": []}`)},
+		"players/aaron-judge.json":        {Data: []byte(`{"people": [{"id": 592450, "fullName": "Aaron Judge"}]}`)},
+		"stats/592450.json":               {Data: []byte(`{"people": [{"id": 592450}]}`)},
+		"gamelog/592450-2024.json":        {Data: []byte(`{"stats": []}`)},
+		"matchup/592450-519242.json":      {Data: []byte(`{"stats": []}`)},
+		"roster/147.json":                 {Data: []byte(`{"roster": []}`)},
+		"game/745444.json":                {Data: []byte(`{"gamePk": 745444}`)},
+		"game/README.md":                  {Data: []byte("not a fixture")},
+		"schedule/2024-07-04.json":        {Data: []byte(`{"dates": [{"date": "2024-07-04", "games": []}]}`)},
+		"splits/592450-2024-hitting.json": {Data: []byte(`{"stats": []}`)},
+	}
+
+	f, err := LoadFixtures(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func(DataSource) error
+	}{
+		{"teams", func(s DataSource) error { _, err := s.GetTeams(ctx); return err }},
+		{"standings", func(s DataSource) error {
+			_, err := s.GetStandings(ctx, StandingsQuery{Season: "2024", Type: StandingsWildCard})
+			return err
+		}},
+		{"schedule", func(s DataSource) error { _, err := s.GetSchedule(ctx, ScheduleOn("2024-07-04")); return err }},
+		{"player search", func(s DataSource) error { _, err := s.SearchPlayer(ctx, " Aaron Judge "); return err }},
+		{"player stats", func(s DataSource) error { _, err := s.GetPlayerStats(ctx, "592450"); return err }},
+		{"game log", func(s DataSource) error { _, err := s.GetGameLog(ctx, "592450", "2024"); return err }},
+		{"splits", func(s DataSource) error {
+			_, err := s.GetSplits(ctx, SplitsQuery{PlayerID: "592450", Season: "2024", Group: "hitting"})
+			return err
+		}},
+		{"matchup", func(s DataSource) error { _, err := s.GetMatchup(ctx, "592450", "519242"); return err }},
+		{"roster", func(s DataSource) error { _, err := s.GetRoster(ctx, "147"); return err }},
+		{"game feed", func(s DataSource) error { _, err := s.GetGameFeed(ctx, "745444"); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(f); err != nil {
+				t.Errorf("loaded fixture: %v", err)
+			}
+			if err := tt.call(NewFixtureClient()); KindOf(err) != KindNotFound {
+				t.Errorf("missing fixture: error = %v, want not found", err)
+			}
+		})
+	}
+
+	if got := f.Players["aaron-judge"].People[0].FullName; got != "Aaron Judge" {
+		t.Errorf("player fixture name = %q, want Aaron Judge", got)
+	}
+	if len(f.Games) != 1 {
+		t.Errorf("loaded %d game fixtures, want 1 (non-JSON files are skipped)", len(f.Games))
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := f.GetTeams(cancelled); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetTeams(cancelled) error = %v, want context.Canceled", err)
+	}
+
+	_, err = LoadFixtures(fstest.MapFS{"roster/147.json": {Data: []byte("{")}})
+	if err == nil || !strings.Contains(err.Error(), "roster/147.json") {
+		t.Errorf("LoadFixtures(bad JSON) error = %v, want one naming the file", err)
+	}
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestInflightCoalesces(t *testing.T) {
+	var g inflight
+	var calls atomic.Int32
+	release := make(chan struct{})
+
+	fn := func(ctx context.Context) ([]byte, error) {
+		calls.Add(1)
+		<-release
+		return []byte("body"), nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]string, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := g.do(context.Background(), "key", fn)
+			if err != nil {
+				t.Error(err)
+			}
+			results[i] = string(data)
+		}()
+	}
+	waitForWaiters(t, &g, "key", len(results))
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("fn called %d times, want 1", n)
+	}
+	for i, r := range results {
+		if r != "body" {
+			t.Errorf("caller %d got %q, want %q", i, r, "body")
+		}
+	}
+
+	// A finished call is forgotten, so the next one runs again
+	if _, err := g.do(context.Background(), "key", func(ctx context.Context) ([]byte, error) {
+		calls.Add(1)
+		return nil, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("fn called %d times after the first call finished, want 2", n)
+	}
+}
+
+func TestInflightSharesErrors(t *testing.T) {
+	var g inflight
+	boom := errors.New("boom")
+	if _, err := g.do(context.Background(), "key", func(ctx context.Context) ([]byte, error) {
+		return nil, boom
+	}); !errors.Is(err, boom) {
+		t.Errorf("do() error = %v, want %v", err, boom)
+	}
+}
+
+func TestInflightCancellation(t *testing.T) {
+	var g inflight
+	started := make(chan struct{})
+	stopped := make(chan error, 1)
+	fn := func(ctx context.Context) ([]byte, error) {
+		close(started)
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		return nil, ctx.Err()
+	}
+
+	first, cancelFirst := context.WithCancel(context.Background())
+	second, cancelSecond := context.WithCancel(context.Background())
+	errs := make(chan error, 2)
+	go func() {
+		_, err := g.do(first, "key", fn)
+		errs <- err
+	}()
+	<-started
+	go func() {
+		_, err := g.do(second, "key", fn)
+		errs <- err
+	}()
+	waitForWaiters(t, &g, "key", 2)
+
+	// One caller giving up returns right away but leaves the call running
+	cancelFirst()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled caller error = %v, want context.Canceled", err)
+	}
+	select {
+	case <-stopped:
+		t.Fatal("shared call cancelled while a caller was still waiting")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// The last caller giving up cancels the call itself
+	cancelSecond()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled caller error = %v, want context.Canceled", err)
+	}
+	select {
+	case err := <-stopped:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("shared call stopped with %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("shared call not cancelled after every caller gave up")
+	}
+}
+
+// waitForWaiters blocks until n callers are waiting on the call for key
+func waitForWaiters(t *testing.T, g *inflight, key string, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		g.mu.Lock()
+		c, ok := g.calls[key]
+		waiting := ok && c.waiters == n
+		g.mu.Unlock()
+		if waiting {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d callers", n)
+}
+
+func TestBatchKeepsOrder(t *testing.T) {
+	items := []int{5, 1, 4, 2, 3}
+	got, err := Batch(context.Background(), 2, items, func(ctx context.Context, n int) (int, error) {
+		// Finish out of order so results have to be placed by index
+		time.Sleep(time.Duration(n) * time.Millisecond)
+		return n * 10, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{50, 10, 40, 20, 30}; !slices.Equal(got, want) {
+		t.Errorf("Batch() = %v, want %v", got, want)
+	}
+}
+
+func TestBatchLimitsWorkers(t *testing.T) {
+	tests := []struct {
+		workers int
+		want    int32
+	}{
+		{1, 1},
+		{3, 3},
+		{0, 8}, // unlimited
+	}
+
+	for _, tt := range tests {
+		var running, peak atomic.Int32
+		_, err := Batch(context.Background(), tt.workers, make([]int, 8), func(ctx context.Context, _ int) (struct{}, error) {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			running.Add(-1)
+			return struct{}{}, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := peak.Load(); got > tt.want || (tt.workers > 0 && got != tt.want) {
+			t.Errorf("workers=%d: peak concurrency %d, want %d", tt.workers, got, tt.want)
+		}
+	}
+}
+
+func TestBatchFirstErrorCancels(t *testing.T) {
+	boom := errors.New("boom")
+	var cancelled atomic.Int32
+
+	_, err := Batch(context.Background(), 4, []int{0, 1, 2, 3}, func(ctx context.Context, n int) (int, error) {
+		if n == 0 {
+			return 0, boom
+		}
+		select {
+		case <-ctx.Done():
+			cancelled.Add(1)
+			return 0, ctx.Err()
+		case <-time.After(time.Second):
+			return n, nil
+		}
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("Batch() error = %v, want %v", err, boom)
+	}
+	if cancelled.Load() != 3 {
+		t.Errorf("%d calls cancelled, want 3", cancelled.Load())
+	}
+}
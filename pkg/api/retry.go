@@ -0,0 +1,88 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Only rate limiting
+// (429), server errors (5xx) and timeouts are retried.
+type RetryPolicy struct {
+	MaxRetries int           // retries after the first attempt; 0 disables
+	BaseDelay  time.Duration // backoff before the first retry
+	MaxDelay   time.Duration // cap on any single backoff
+}
+
+// DefaultRetryPolicy is used by NewClient unless overridden with WithRetry
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   10 * time.Second,
+}
+
+// retryable reports whether err is worth another attempt
+func retryable(err error) bool {
+	switch KindOf(err) {
+	case KindRateLimited, KindUpstream:
+		return true
+	case KindNetwork:
+		var netErr net.Error
+		return errors.As(err, &netErr) && netErr.Timeout()
+	}
+	return false
+}
+
+// backoff returns how long to wait before retry number attempt (0-based).
+// A Retry-After from the server wins, capped at MaxDelay so a hostile or
+// broken server can't stall the client; otherwise it is exponential backoff
+// with full jitter, so parallel callers don't retry in lockstep.
+func (p RetryPolicy) backoff(attempt int, err error) time.Duration {
+	var apiErr *Error
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		if p.MaxDelay > 0 && apiErr.RetryAfter > p.MaxDelay {
+			return p.MaxDelay
+		}
+		return apiErr.RetryAfter
+	}
+
+	limit := p.MaxDelay
+	if shift := p.BaseDelay << attempt; shift > 0 && shift < limit {
+		limit = shift
+	}
+	if limit <= 0 {
+		return 0
+	}
+	return rand.N(limit + 1)
+}
+
+// sleep waits for d or until ctx is cancelled
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
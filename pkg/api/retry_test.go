@@ -0,0 +1,62 @@
+package api
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 3, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		name    string
+		policy  RetryPolicy
+		attempt int
+		err     error
+		min     time.Duration
+		max     time.Duration
+	}{
+		{"first attempt", policy, 0, errors.New("boom"), 0, 100 * time.Millisecond},
+		{"grows with attempt", policy, 2, errors.New("boom"), 0, 400 * time.Millisecond},
+		{"capped at max delay", policy, 10, errors.New("boom"), 0, time.Second},
+		{"shift overflow", policy, 62, errors.New("boom"), 0, time.Second},
+		{"retry after wins", policy, 0, &Error{Kind: KindRateLimited, RetryAfter: 700 * time.Millisecond}, 700 * time.Millisecond, 700 * time.Millisecond},
+		{"retry after capped", policy, 0, &Error{Kind: KindRateLimited, RetryAfter: time.Hour}, time.Second, time.Second},
+		{"retry after without cap", RetryPolicy{BaseDelay: time.Second}, 0, &Error{Kind: KindRateLimited, RetryAfter: time.Minute}, time.Minute, time.Minute},
+		{"no delays", RetryPolicy{}, 3, errors.New("boom"), 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				got := tt.policy.backoff(tt.attempt, tt.err)
+				if got < tt.min || got > tt.max {
+					t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.attempt, got, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"5", 5 * time.Second},
+		{"-1", 0},
+		{"soon", 0},
+		{"Mon, 01 Jul 2024 12:00:30 GMT", 30 * time.Second},
+		{"Mon, 01 Jul 2024 11:00:00 GMT", 0},
+	}
+
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// gameTypes maps the game type names accepted by ResolveGameType to the
+// MLB API's gameType codes
+var gameTypes = map[string][]string{
+	"regular":    {"R"},
+	"postseason": {"F", "D", "L", "W"}, // wild card, division, LCS, World Series
+	"spring":     {"S"},
+	"exhibition": {"E"},
+}
+
+// GameTypeNames lists the names accepted by ResolveGameType
+var GameTypeNames = []string{"regular", "postseason", "spring", "exhibition"}
+
+// scheduleHydrations are the extra fields requested with every schedule
+const scheduleHydrations = "probablePitcher,decisions,seriesStatus"
+
+// ScheduleQuery selects the games returned by GetSchedule. Zero fields are
+// left to the API's defaults, so an empty query returns today's games.
+type ScheduleQuery struct {
+	StartDate string   // YYYY-MM-DD; the only day when EndDate is empty
+	EndDate   string   // YYYY-MM-DD, inclusive
+	TeamID    string   // as returned by ResolveTeamID
+	GameTypes []string // codes as returned by ResolveGameType
+}
+
+// ScheduleOn returns a query for a single day
+func ScheduleOn(date string) ScheduleQuery {
+	return ScheduleQuery{StartDate: date}
+}
+
+// values encodes the query as schedule endpoint parameters
+func (q ScheduleQuery) values() url.Values {
+	v := url.Values{}
+	v.Set("sportId", "1")
+	v.Set("hydrate", scheduleHydrations)
+	switch {
+	case q.EndDate != "":
+		v.Set("startDate", q.StartDate)
+		v.Set("endDate", q.EndDate)
+	case q.StartDate != "":
+		v.Set("date", q.StartDate)
+	}
+	if q.TeamID != "" {
+		v.Set("teamId", q.TeamID)
+	}
+	if len(q.GameTypes) > 0 {
+		v.Set("gameType", strings.Join(q.GameTypes, ","))
+	}
+	return v
+}
+
+// matches reports whether a game satisfies the team and game type filters
+func (q ScheduleQuery) matches(g models.ScheduleGame) bool {
+	if q.TeamID != "" && q.TeamID != strconv.Itoa(g.Teams.Away.Team.ID) && q.TeamID != strconv.Itoa(g.Teams.Home.Team.ID) {
+		return false
+	}
+	if len(q.GameTypes) > 0 && !slices.Contains(q.GameTypes, g.GameType) {
+		return false
+	}
+	return true
+}
+
+// ResolveGameType resolves a game type name such as "postseason" to the
+// MLB API's gameType codes
+func ResolveGameType(name string) ([]string, error) {
+	if codes, ok := gameTypes[strings.ToLower(name)]; ok {
+		return codes, nil
+	}
+	return nil, newError(KindInvalidInput, "unknown game type: %s (use %s)", name, strings.Join(GameTypeNames, ", "))
+}
+
+// LocalSchedule returns the games whose first pitch falls between the
+// query's start and end dates in loc, grouped by their local date. The API
+// files games under their official US date, so a day either side of the
+// range is fetched and regrouped; games without a start time keep their
+// official date.
+func LocalSchedule(ctx context.Context, src DataSource, query ScheduleQuery, loc *time.Location) (*models.ScheduleResponse, error) {
+	start, end := query.StartDate, query.EndDate
+	if end == "" {
+		end = start
+	}
+	first, err := time.Parse("2006-01-02", start)
+	if err != nil {
+		return nil, newError(KindInvalidInput, "invalid date: %s (use YYYY-MM-DD)", start)
+	}
+	last, err := time.Parse("2006-01-02", end)
+	if err != nil {
+		return nil, newError(KindInvalidInput, "invalid date: %s (use YYYY-MM-DD)", end)
+	}
+
+	wide := query
+	wide.StartDate = first.AddDate(0, 0, -1).Format("2006-01-02")
+	wide.EndDate = last.AddDate(0, 0, 1).Format("2006-01-02")
+	resp, err := src.GetSchedule(ctx, wide)
+	if err != nil {
+		return nil, err
+	}
+
+	byDate := make(map[string][]models.ScheduleGame)
+	for _, d := range resp.Dates {
+		for _, g := range d.Games {
+			date := d.Date
+			if t, ok := g.StartTime(); ok {
+				date = t.In(loc).Format("2006-01-02")
+			}
+			if date >= start && date <= end {
+				byDate[date] = append(byDate[date], g)
+			}
+		}
+	}
+
+	dates := make([]string, 0, len(byDate))
+	for date := range byDate {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+
+	local := &models.ScheduleResponse{}
+	for _, date := range dates {
+		games := byDate[date]
+		sort.SliceStable(games, func(i, j int) bool { return games[i].GameDate < games[j].GameDate })
+		local.Dates = append(local.Dates, models.ScheduleDate{Date: date, Games: games})
+	}
+	return local, nil
+}
+
+// scheduleFixture assembles the fixture days covered by a query and drops
+// the games it filters out
+func scheduleFixture(days map[string]*models.ScheduleResponse, q ScheduleQuery) (*models.ScheduleResponse, bool) {
+	end := q.EndDate
+	if end == "" {
+		end = q.StartDate
+	}
+
+	var keys []string
+	for date := range days {
+		if date >= q.StartDate && date <= end {
+			keys = append(keys, date)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, false
+	}
+	sort.Strings(keys)
+
+	resp := &models.ScheduleResponse{}
+	for _, key := range keys {
+		for _, d := range days[key].Dates {
+			day := models.ScheduleDate{Date: d.Date}
+			for _, g := range d.Games {
+				if q.matches(g) {
+					day.Games = append(day.Games, g)
+				}
+			}
+			if len(day.Games) > 0 {
+				resp.Dates = append(resp.Dates, day)
+			}
+		}
+	}
+	return resp, true
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLocalSchedule(t *testing.T) {
+	src, err := LoadFixtures(os.DirFS("testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+
+	tests := []struct {
+		name  string
+		query ScheduleQuery
+		loc   *time.Location
+		want  map[string][]int // gamePks by local date
+	}{
+		// The late west coast game stays on the 4th; the TBD game keeps its
+		// official date and sorts by its placeholder time
+		{"eastern", ScheduleOn("2024-07-04"), newYork, map[string][]int{"2024-07-04": {23, 21, 22}}},
+		// The 3rd's night game is the morning of the 4th in Tokyo, and the
+		// 4th's games move to the 5th
+		{"tokyo", ScheduleOn("2024-07-04"), tokyo, map[string][]int{"2024-07-04": {11, 23}}},
+		{"range", ScheduleQuery{StartDate: "2024-07-04", EndDate: "2024-07-05"}, newYork, map[string][]int{
+			"2024-07-04": {23, 21, 22},
+			"2024-07-05": {31},
+		}},
+		{"team", ScheduleQuery{StartDate: "2024-07-04", TeamID: "147"}, newYork, map[string][]int{"2024-07-04": {21}}},
+		{"game type", ScheduleQuery{StartDate: "2024-07-04", GameTypes: []string{"S"}}, newYork, map[string][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := LocalSchedule(context.Background(), src, tt.query, tt.loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[string][]int)
+			for _, d := range resp.Dates {
+				for _, g := range d.Games {
+					got[d.Date] = append(got[d.Date], g.GamePk)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("LocalSchedule() dates = %v, want %v", got, tt.want)
+			}
+			for date, want := range tt.want {
+				if !slices.Equal(got[date], want) {
+					t.Errorf("LocalSchedule() %s = %v, want %v", date, got[date], want)
+				}
+			}
+		})
+	}
+}
+
+func TestLocalScheduleInvalidDate(t *testing.T) {
+	for _, q := range []ScheduleQuery{ScheduleOn("July 4"), {StartDate: "2024-07-04", EndDate: "2024-13-01"}} {
+		if _, err := LocalSchedule(context.Background(), NewFixtureClient(), q, time.UTC); KindOf(err) != KindInvalidInput {
+			t.Errorf("LocalSchedule(%+v) error = %v, want invalid input", q, err)
+		}
+	}
+}
+
+func TestScheduleQueryValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		query ScheduleQuery
+		want  string
+	}{
+		{"today", ScheduleQuery{}, "hydrate=probablePitcher%2Cdecisions%2CseriesStatus&sportId=1"},
+		{"one day", ScheduleOn("2024-07-04"), "date=2024-07-04&hydrate=probablePitcher%2Cdecisions%2CseriesStatus&sportId=1"},
+		{"range, team and types", ScheduleQuery{StartDate: "2024-10-01", EndDate: "2024-10-31", TeamID: "147", GameTypes: []string{"F", "D"}},
+			"endDate=2024-10-31&gameType=F%2CD&hydrate=probablePitcher%2Cdecisions%2CseriesStatus&sportId=1&startDate=2024-10-01&teamId=147"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.query.values().Encode(); got != tt.want {
+			t.Errorf("%s: values() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestResolveGameType(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"regular", []string{"R"}},
+		{"Postseason", []string{"F", "D", "L", "W"}},
+		{"spring", []string{"S"}},
+		{"playoffs", nil},
+	}
+
+	for _, tt := range tests {
+		got, err := ResolveGameType(tt.name)
+		if tt.want == nil {
+			if KindOf(err) != KindInvalidInput {
+				t.Errorf("ResolveGameType(%q) error = %v, want invalid input", tt.name, err)
+			}
+			continue
+		}
+		if err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("ResolveGameType(%q) = %v, %v, want %v", tt.name, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"context"
+
+	"github.com/sgracia13/mlb-cli/pkg/models"
+)
+
+// DataSource is the set of MLB lookups used by the commands and the TUI.
+// Client implements it against the live Stats API; FixtureClient implements
+// it from canned responses for tests and demos. Every method stops work and
+// returns ctx.Err() once ctx is cancelled.
+type DataSource interface {
+	GetTeams(ctx context.Context) (*models.TeamsResponse, error)
+	GetStandings(ctx context.Context, query StandingsQuery) (*models.StandingsResponse, error)
+	GetSchedule(ctx context.Context, query ScheduleQuery) (*models.ScheduleResponse, error)
+	SearchPlayer(ctx context.Context, name string) (*models.PlayerSearchResponse, error)
+	GetPlayerStats(ctx context.Context, playerID string) (*models.PlayerStatsResponse, error)
+	GetGameLog(ctx context.Context, playerID, season string) (*models.StatsResponse, error)
+	GetSplits(ctx context.Context, query SplitsQuery) (*models.StatsResponse, error)
+	GetMatchup(ctx context.Context, batterID, pitcherID string) (*models.StatsResponse, error)
+	GetRoster(ctx context.Context, teamID string) (*models.RosterResponse, error)
+	GetGameFeed(ctx context.Context, gamePk string) (*models.GameFeedResponse, error)
+}
+
+var (
+	_ DataSource = (*Client)(nil)
+	_ DataSource = (*FixtureClient)(nil)
+)
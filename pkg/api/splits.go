@@ -0,0 +1,74 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+)
+
+// splitCategories maps the split category names accepted by ResolveSplits
+// to the MLB API's sitCodes, in display order
+var splitCategories = map[string][]string{
+	"platoon":  {"vl", "vr"},                              // vs left- and right-handed pitchers (or batters)
+	"venue":    {"h", "a"},                                // home, away
+	"daynight": {"d", "n"},                                // day, night
+	"month":    {"3", "4", "5", "6", "7", "8", "9", "10"}, // March through October
+	"runners":  {"r0", "risp"},                            // bases empty, runners in scoring position
+}
+
+// SplitCategoryNames lists the names accepted by ResolveSplits, in display
+// order
+var SplitCategoryNames = []string{"platoon", "venue", "dayNight", "month", "runners"}
+
+// SplitsQuery selects the situational splits returned by GetSplits
+type SplitsQuery struct {
+	PlayerID string
+	Season   string   // e.g. "2024"
+	Group    string   // models.GroupHitting or models.GroupPitching
+	SitCodes []string // as returned by ResolveSplits
+}
+
+// values encodes the query as stats endpoint parameters
+func (q SplitsQuery) values() url.Values {
+	v := url.Values{}
+	v.Set("stats", "statSplits")
+	v.Set("group", q.Group)
+	v.Set("season", q.Season)
+	v.Set("sitCodes", strings.Join(q.SitCodes, ","))
+	return v
+}
+
+// fixtureKey names the fixture for a query, e.g. "660271-2024-hitting"
+func (q SplitsQuery) fixtureKey() string {
+	return strings.Join([]string{q.PlayerID, q.Season, q.Group}, "-")
+}
+
+// ResolveSplits resolves split category names such as "platoon" to sitCodes
+// in display order. No names selects every category.
+func ResolveSplits(names []string) ([]string, error) {
+	if len(names) == 0 {
+		names = SplitCategoryNames
+	}
+
+	var codes []string
+	for _, name := range names {
+		category, ok := splitCategories[strings.ToLower(name)]
+		if !ok {
+			return nil, newError(KindInvalidInput, "unknown split: %s (use %s)", name, strings.Join(SplitCategoryNames, ", "))
+		}
+		codes = append(codes, category...)
+	}
+	return codes, nil
+}
+
+// SplitCategory returns the category name of a sitCode, e.g. "platoon" for
+// "vl", or "" for a code outside every category
+func SplitCategory(code string) string {
+	for name, codes := range splitCategories {
+		for _, c := range codes {
+			if c == code {
+				return name
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestResolveSplits(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  []string
+		kind  ErrorKind
+	}{
+		{nil, []string{"vl", "vr", "h", "a", "d", "n", "3", "4", "5", "6", "7", "8", "9", "10", "r0", "risp"}, KindUnknown},
+		{[]string{"platoon"}, []string{"vl", "vr"}, KindUnknown},
+		{[]string{"runners", "dayNight"}, []string{"r0", "risp", "d", "n"}, KindUnknown},
+		{[]string{"VENUE"}, []string{"h", "a"}, KindUnknown},
+		{[]string{"platoon", "clutch"}, nil, KindInvalidInput},
+	}
+
+	for _, tt := range tests {
+		got, err := ResolveSplits(tt.names)
+		if KindOf(err) != tt.kind {
+			t.Errorf("ResolveSplits(%v) error = %v, want kind %v", tt.names, err, tt.kind)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ResolveSplits(%v) = %v, want %v", tt.names, got, tt.want)
+		}
+	}
+}
+
+func TestSplitCategory(t *testing.T) {
+	tests := map[string]string{"vl": "platoon", "a": "venue", "n": "daynight", "10": "month", "risp": "runners", "xyz": ""}
+	for code, want := range tests {
+		if got := SplitCategory(code); got != want {
+			t.Errorf("SplitCategory(%q) = %q, want %q", code, got, want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Standings types, as used in StandingsQuery.Type and returned in
+// StandingsRecord.StandingsType
+const (
+	StandingsDivision       = "regularSeason"
+	StandingsWildCard       = "wildCard"
+	StandingsLeague         = "byLeague"
+	StandingsSpringTraining = "springTraining"
+	StandingsPostseason     = "postseason"
+)
+
+// standingsTypes maps the standings type names accepted by
+// ResolveStandingsType to standings types
+var standingsTypes = map[string]string{
+	"division":       StandingsDivision,
+	"wildcard":       StandingsWildCard,
+	"league":         StandingsLeague,
+	"springtraining": StandingsSpringTraining,
+	"postseason":     StandingsPostseason,
+}
+
+// StandingsTypeNames lists the names accepted by ResolveStandingsType
+var StandingsTypeNames = []string{"division", "wildcard", "league", "springTraining", "postseason"}
+
+// WildCardSpots is the number of wild card playoff spots in each league
+const WildCardSpots = 3
+
+// StandingsQuery selects the standings returned by GetStandings
+type StandingsQuery struct {
+	Season string // e.g. "2024"
+	Type   string // as returned by ResolveStandingsType; empty for division standings
+	Date   string // YYYY-MM-DD for standings as of that day; empty for the latest
+}
+
+// SeasonStandings returns a query for a season's division standings
+func SeasonStandings(season string) StandingsQuery {
+	return StandingsQuery{Season: season}
+}
+
+// values encodes the query as standings endpoint parameters
+func (q StandingsQuery) values() url.Values {
+	v := url.Values{}
+	v.Set("leagueId", "103,104")
+	v.Set("season", q.Season)
+	standingsType := q.Type
+	if standingsType == "" {
+		standingsType = StandingsDivision
+	}
+	v.Set("standingsTypes", standingsType)
+	if q.Date != "" {
+		v.Set("date", q.Date)
+	}
+	return v
+}
+
+// fixtureKey names the fixture for a query: the season, followed by the
+// standings type and date when they are set, e.g. "2024-wildCard-2024-07-01"
+func (q StandingsQuery) fixtureKey() string {
+	parts := []string{q.Season}
+	if q.Type != "" && q.Type != StandingsDivision {
+		parts = append(parts, q.Type)
+	}
+	if q.Date != "" {
+		parts = append(parts, q.Date)
+	}
+	return strings.Join(parts, "-")
+}
+
+// ResolveStandingsType resolves a standings type name such as "wildcard"
+// to the MLB API's standingsTypes value
+func ResolveStandingsType(name string) (string, error) {
+	if standingsType, ok := standingsTypes[strings.ToLower(name)]; ok {
+		return standingsType, nil
+	}
+	return "", newError(KindInvalidInput, "unknown standings type: %s (use %s)", name, strings.Join(StandingsTypeNames, ", "))
+}
@@ -0,0 +1,50 @@
+package api
+
+import "testing"
+
+func TestStandingsQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  StandingsQuery
+		values string
+		key    string
+	}{
+		{"division", SeasonStandings("2024"), "leagueId=103%2C104&season=2024&standingsTypes=regularSeason", "2024"},
+		{"explicit division", StandingsQuery{Season: "2024", Type: StandingsDivision}, "leagueId=103%2C104&season=2024&standingsTypes=regularSeason", "2024"},
+		{"wild card", StandingsQuery{Season: "2024", Type: StandingsWildCard}, "leagueId=103%2C104&season=2024&standingsTypes=wildCard", "2024-wildCard"},
+		{"by date", StandingsQuery{Season: "2024", Type: StandingsLeague, Date: "2024-07-01"},
+			"date=2024-07-01&leagueId=103%2C104&season=2024&standingsTypes=byLeague", "2024-byLeague-2024-07-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.values().Encode(); got != tt.values {
+				t.Errorf("values() = %s, want %s", got, tt.values)
+			}
+			if got := tt.query.fixtureKey(); got != tt.key {
+				t.Errorf("fixtureKey() = %s, want %s", got, tt.key)
+			}
+		})
+	}
+}
+
+func TestResolveStandingsType(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+		kind ErrorKind
+	}{
+		{"division", StandingsDivision, KindUnknown},
+		{"wildcard", StandingsWildCard, KindUnknown},
+		{"WildCard", StandingsWildCard, KindUnknown},
+		{"springTraining", StandingsSpringTraining, KindUnknown},
+		{"standings", "", KindInvalidInput},
+	}
+
+	for _, tt := range tests {
+		got, err := ResolveStandingsType(tt.name)
+		if got != tt.want || KindOf(err) != tt.kind {
+			t.Errorf("ResolveStandingsType(%q) = %q, %v, want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
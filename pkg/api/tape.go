@@ -0,0 +1,74 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// Tape is a directory of recorded API responses. A client with
+// WithRecorder writes every response it returns to the tape; a client with
+// WithReplay serves responses from it without touching the network.
+type Tape struct {
+	dir string
+}
+
+// NewTape creates a tape stored in dir
+func NewTape(dir string) *Tape {
+	return &Tape{dir: dir}
+}
+
+// tapeEntry is the on-disk form of one recorded response
+type tapeEntry struct {
+	Request string          `json:"request"`
+	Body    json.RawMessage `json:"body"`
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9-]+`)
+
+// path maps a request (URL relative to the base URL) to its file. The name
+// keeps a readable prefix of the request plus a hash for uniqueness.
+func (t *Tape) path(request string) string {
+	name := unsafeFileChars.ReplaceAllString(request, "_")
+	if len(name) > 60 {
+		name = name[:60]
+	}
+	sum := sha256.Sum256([]byte(request))
+	return filepath.Join(t.dir, name+"-"+hex.EncodeToString(sum[:4])+".json")
+}
+
+// Save records the response body for request
+func (t *Tape) Save(request string, body []byte) error {
+	if err := os.MkdirAll(t.dir, 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(tapeEntry{Request: request, Body: body}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(t.path(request), data, 0o644)
+}
+
+// Load returns the recorded response body for request
+func (t *Tape) Load(request string) ([]byte, error) {
+	data, err := os.ReadFile(t.path(request))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, newError(KindNotFound, "no recorded response for %s in %s", request, t.dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entry tapeEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, &Error{Kind: KindDecode, Err: fmt.Errorf("failed to parse recorded response: %w", err)}
+	}
+	return entry.Body, nil
+}
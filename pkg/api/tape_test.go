@@ -0,0 +1,159 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTapePath(t *testing.T) {
+	tape := NewTape("tape")
+
+	tests := []struct {
+		request string
+		want    string
+	}{
+		{"/v1/teams?sportId=1", "_v1_teams_sportId_1-733b6d01.json"},
+		{"/v1.1/game/745444/feed/live", "_v1_1_game_745444_feed_live-859d2b91.json"},
+		{"/v1/people/search?names=shohei%20ohtani", "_v1_people_search_names_shohei_20ohtani-c321d49f.json"},
+		// Long requests keep a 60 character prefix
+		{"/v1/schedule?sportId=1&startDate=2024-07-01&endDate=2024-07-31&teamId=147&gameType=R",
+			"_v1_schedule_sportId_1_startDate_2024-07-01_endDate_2024-07--b834dec1.json"},
+	}
+
+	for _, tt := range tests {
+		if got := tape.path(tt.request); got != filepath.Join("tape", tt.want) {
+			t.Errorf("path(%q) = %q, want %q", tt.request, got, tt.want)
+		}
+	}
+
+	// Requests that only differ in punctuation still get their own files
+	if tape.path("/v1/teams?a=1") == tape.path("/v1/teams?a_1") {
+		t.Error("different requests map to the same file")
+	}
+}
+
+func TestTapeSaveLoad(t *testing.T) {
+	tape := NewTape(filepath.Join(t.TempDir(), "nested", "tape"))
+	body := []byte(`{"teams": []}`)
+
+	if err := tape.Save("/v1/teams?sportId=1", body); err != nil {
+		t.Fatal(err)
+	}
+	got, err := tape.Load("/v1/teams?sportId=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The body is stored indented inside the entry
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, got); err != nil || compact.String() != `{"teams":[]}` {
+		t.Errorf("Load() = %s, want %s", got, body)
+	}
+
+	if _, err := tape.Load("/v1/teams?sportId=2"); KindOf(err) != KindNotFound {
+		t.Errorf("Load(unrecorded) error = %v, want not found", err)
+	}
+
+	if err := os.WriteFile(tape.path("/v1/broken"), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tape.Load("/v1/broken"); KindOf(err) != KindDecode {
+		t.Errorf("Load(corrupt) error = %v, want decode", err)
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	responses := map[string]string{
+		"/api/v1/teams":                   `{"teams": [{"id": 147, "name": "New York Yankees"}]}`,
+		"/api/v1.1/game/745444/feed/live": `{"gamePk": 745444}`,
+		"/api/v1/people/search":           `{"people": [{"id": 660271, "fullName": "Shohei Ohtani"}]}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	calls := func(c *Client) error {
+		if _, err := c.GetTeams(ctx); err != nil {
+			return err
+		}
+		if _, err := c.GetGameFeed(ctx, "745444"); err != nil {
+			return err
+		}
+		_, err := c.SearchPlayer(ctx, "Shohei Ohtani")
+		return err
+	}
+
+	if err := calls(NewClient(WithBaseURL(srv.URL+"/api/v1"), WithRecorder(NewTape(dir)))); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	// Keys are relative to the host and keep the API version, so a tape
+	// replays against any base URL
+	var keys []string
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var entry tapeEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, entry.Request)
+	}
+	slices.Sort(keys)
+	want := []string{
+		"/v1.1/game/745444/feed/live",
+		"/v1/people/search?names=Shohei%20Ohtani&sportId=1",
+		"/v1/teams?sportId=1",
+	}
+	if !slices.Equal(keys, want) {
+		t.Errorf("recorded %q, want %q", keys, want)
+	}
+
+	replay := NewClient(WithBaseURL("http://offline.invalid/api/v1"), WithReplay(NewTape(dir)))
+	if err := calls(replay); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	teams, err := replay.GetTeams(ctx)
+	if err != nil || len(teams.Teams) != 1 || teams.Teams[0].ID != 147 {
+		t.Errorf("replayed teams = %+v, %v", teams, err)
+	}
+	if _, err := replay.GetRoster(ctx, "147"); KindOf(err) != KindNotFound {
+		t.Errorf("unrecorded request error = %v, want not found", err)
+	}
+}
+
+func TestReplayLegacyKeys(t *testing.T) {
+	// Tapes from before the key kept the API version
+	dir := t.TempDir()
+	if err := NewTape(dir).Save("/teams?sportId=1", []byte(`{"teams": [{"id": 119}]}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	replay := NewClient(WithReplay(NewTape(dir)))
+	teams, err := replay.GetTeams(context.Background())
+	if err != nil || len(teams.Teams) != 1 || teams.Teams[0].ID != 119 {
+		t.Errorf("GetTeams() from a legacy tape = %+v, %v", teams, err)
+	}
+	if _, err := replay.GetGameFeed(context.Background(), "745444"); KindOf(err) != KindNotFound || !strings.Contains(err.Error(), "/v1.1/game/745444/feed/live") {
+		t.Errorf("unrecorded request error = %v, want not found for its current key", err)
+	}
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Trace verbosity levels; each level includes everything below it
+const (
+	TraceRequests = 1 // URL, status and latency of every request
+	TraceRetries  = 2 // retries and rate limiter waits
+	TraceHeaders  = 3 // request and response headers
+	TraceBodies   = 4 // response bodies, truncated to maxTraceBody
+)
+
+// maxTraceBody caps how much of a response body is dumped
+const maxTraceBody = 2048
+
+// redactedHeaders carry credentials, so their values are never traced
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// tracer writes HTTP diagnostics for the enabled verbosity level
+type tracer struct {
+	mu     sync.Mutex
+	w      io.Writer
+	level  int
+	secret map[string]bool // canonical header names printed as ***
+}
+
+// WithTrace logs HTTP activity to w at the given verbosity level (0 disables)
+func WithTrace(w io.Writer, level int) Option {
+	return func(c *Client) {
+		if level > 0 {
+			c.trace = &tracer{w: w, level: level, secret: make(map[string]bool)}
+			for _, name := range redactedHeaders {
+				c.trace.secret[name] = true
+			}
+		}
+	}
+}
+
+// redact hides the values of headers, such as the API keys a profile
+// sends with every request
+func (t *tracer) redact(headers map[string]string) {
+	if t == nil {
+		return
+	}
+	for name := range headers {
+		t.secret[http.CanonicalHeaderKey(name)] = true
+	}
+}
+
+func (t *tracer) enabled(level int) bool {
+	return t != nil && t.level >= level
+}
+
+func (t *tracer) printf(level int, format string, args ...any) {
+	if !t.enabled(level) {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprintf(t.w, format+"\n", args...)
+}
+
+// request logs a completed round trip
+func (t *tracer) request(req *http.Request, resp *http.Response, body []byte, elapsed time.Duration) {
+	if !t.enabled(TraceRequests) {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fmt.Fprintf(t.w, "%s %s %s in %d ms\n", req.Method, req.URL, resp.Status, elapsed.Milliseconds())
+	if t.level >= TraceHeaders {
+		fmt.Fprintln(t.w, "Request Headers:")
+		t.writeHeaders(req.Header)
+		fmt.Fprintln(t.w, "Response Headers:")
+		t.writeHeaders(resp.Header)
+	}
+	if t.level >= TraceBodies {
+		if len(body) > maxTraceBody {
+			fmt.Fprintf(t.w, "Response Body: %s ... [truncated %d bytes]\n", body[:maxTraceBody], len(body)-maxTraceBody)
+		} else {
+			fmt.Fprintf(t.w, "Response Body: %s\n", body)
+		}
+	}
+}
+
+// writeHeaders prints headers sorted by name, masking secret values
+func (t *tracer) writeHeaders(h http.Header) {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range h[k] {
+			if t.secret[http.CanonicalHeaderKey(k)] {
+				v = "***"
+			}
+			fmt.Fprintf(t.w, "    %s: %s\n", k, v)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTraceRedactsHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s3cret"})
+		w.Header().Set("X-Request-Id", "abc123")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	c := NewClient(
+		WithTrace(&buf, TraceHeaders),
+		WithHeaders(map[string]string{"x-api-key": "k3y", "Authorization": "Bearer t0ken"}),
+	)
+	if _, err := c.get(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"s3cret", "k3y", "t0ken"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("trace leaked %q:\n%s", secret, out)
+		}
+	}
+	for _, want := range []string{"X-Api-Key: ***", "Authorization: ***", "Set-Cookie: ***", "X-Request-Id: abc123", "User-Agent: mlb-cli"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("trace missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+// Package models contains the MLB Stats API response types returned by
+// package api. Field names follow the API's JSON, so values round-trip
+// through encoding/json without loss of the decoded fields.
+package models
@@ -0,0 +1,245 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GameFeedResponse represents the API response for the live game feed endpoint
+type GameFeedResponse struct {
+	GamePk   int      `json:"gamePk"`
+	GameData GameData `json:"gameData"`
+	LiveData LiveData `json:"liveData"`
+}
+
+// GameData holds the static information about a game
+type GameData struct {
+	Datetime struct {
+		DateTime     string `json:"dateTime"`
+		OfficialDate string `json:"officialDate"`
+	} `json:"datetime"`
+	Status GameStatus `json:"status"`
+	Teams  struct {
+		Away GameTeam `json:"away"`
+		Home GameTeam `json:"home"`
+	} `json:"teams"`
+	Venue struct {
+		Name string `json:"name"`
+	} `json:"venue"`
+	ProbablePitchers struct {
+		Away *PersonRef `json:"away"`
+		Home *PersonRef `json:"home"`
+	} `json:"probablePitchers"`
+}
+
+// GameStatus represents the state of a game
+type GameStatus struct {
+	AbstractGameState string `json:"abstractGameState"` // Preview, Live or Final
+	DetailedState     string `json:"detailedState"`
+}
+
+// IsFinal reports whether the game is over
+func (s GameStatus) IsFinal() bool {
+	return s.AbstractGameState == "Final"
+}
+
+// GameTeam identifies one side of a game
+type GameTeam struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+// PersonRef is a reference to a player
+type PersonRef struct {
+	ID       int    `json:"id"`
+	FullName string `json:"fullName"`
+}
+
+// LiveData holds the in-game state
+type LiveData struct {
+	Linescore Linescore `json:"linescore"`
+	Boxscore  Boxscore  `json:"boxscore"`
+	Decisions Decisions `json:"decisions"`
+	Plays     struct {
+		AllPlays []Play `json:"allPlays"`
+	} `json:"plays"`
+}
+
+// Linescore represents the inning-by-inning score and the current situation
+type Linescore struct {
+	CurrentInning        int               `json:"currentInning"`
+	CurrentInningOrdinal string            `json:"currentInningOrdinal"`
+	InningState          string            `json:"inningState"` // Top, Middle, Bottom or End
+	ScheduledInnings     int               `json:"scheduledInnings"`
+	Innings              []LinescoreInning `json:"innings"`
+	Teams                struct {
+		Away LinescoreTotals `json:"away"`
+		Home LinescoreTotals `json:"home"`
+	} `json:"teams"`
+	Balls   int `json:"balls"`
+	Strikes int `json:"strikes"`
+	Outs    int `json:"outs"`
+	Offense struct {
+		Batter *PersonRef `json:"batter"`
+		OnDeck *PersonRef `json:"onDeck"`
+		First  *PersonRef `json:"first"`
+		Second *PersonRef `json:"second"`
+		Third  *PersonRef `json:"third"`
+	} `json:"offense"`
+	Defense struct {
+		Pitcher *PersonRef `json:"pitcher"`
+	} `json:"defense"`
+}
+
+// LinescoreInning represents both halves of one inning
+type LinescoreInning struct {
+	Num        int         `json:"num"`
+	OrdinalNum string      `json:"ordinalNum"`
+	Away       InningTotal `json:"away"`
+	Home       InningTotal `json:"home"`
+}
+
+// InningTotal represents one team's half inning. Runs is nil for a half
+// inning that was not played, such as the bottom of the 9th.
+type InningTotal struct {
+	Runs   *int `json:"runs"`
+	Hits   int  `json:"hits"`
+	Errors int  `json:"errors"`
+}
+
+// LinescoreTotals represents a team's runs, hits and errors for the game
+type LinescoreTotals struct {
+	Runs       int `json:"runs"`
+	Hits       int `json:"hits"`
+	Errors     int `json:"errors"`
+	LeftOnBase int `json:"leftOnBase"`
+}
+
+// Decisions holds the pitchers of record once a game is final
+type Decisions struct {
+	Winner *PersonRef `json:"winner"`
+	Loser  *PersonRef `json:"loser"`
+	Save   *PersonRef `json:"save"`
+}
+
+// Boxscore holds both teams' individual batting and pitching lines
+type Boxscore struct {
+	Teams struct {
+		Away BoxscoreTeam `json:"away"`
+		Home BoxscoreTeam `json:"home"`
+	} `json:"teams"`
+}
+
+// BoxscoreTeam holds one team's players in the order they appeared
+type BoxscoreTeam struct {
+	Team         GameTeam                  `json:"team"`
+	Players      map[string]BoxscorePlayer `json:"players"` // keyed by "ID" + player ID
+	Batters      []int                     `json:"batters"`
+	Pitchers     []int                     `json:"pitchers"`
+	BattingOrder []int                     `json:"battingOrder"` // starting lineup, once posted
+}
+
+// Player returns the box score entry for a player ID
+func (t BoxscoreTeam) Player(id int) (BoxscorePlayer, bool) {
+	p, ok := t.Players[fmt.Sprintf("ID%d", id)]
+	return p, ok
+}
+
+// Lineup returns the players who batted in batting order. Batters lists
+// substitutes after the starters, so each one is moved under the player
+// whose spot they took, e.g. "301" after "300" and before "400".
+func (t BoxscoreTeam) Lineup() []BoxscorePlayer {
+	var lineup []BoxscorePlayer
+	for _, id := range t.Batters {
+		if p, ok := t.Player(id); ok && p.BattingOrder != "" {
+			lineup = append(lineup, p)
+		}
+	}
+	sort.SliceStable(lineup, func(i, j int) bool {
+		return lineup[i].BattingOrder < lineup[j].BattingOrder
+	})
+	return lineup
+}
+
+// BoxscorePlayer represents one player's game and season lines
+type BoxscorePlayer struct {
+	Person       PersonRef `json:"person"`
+	JerseyNumber string    `json:"jerseyNumber"`
+	Position     struct {
+		Abbreviation string `json:"abbreviation"`
+	} `json:"position"`
+	BattingOrder string `json:"battingOrder"` // "300" for the 3rd spot, "301" for the first substitute there
+	Stats        struct {
+		Batting  BattingLine  `json:"batting"`
+		Pitching PitchingLine `json:"pitching"`
+	} `json:"stats"`
+	SeasonStats struct {
+		Batting struct {
+			Avg string `json:"avg"`
+			OPS string `json:"ops"`
+		} `json:"batting"`
+		Pitching struct {
+			ERA string `json:"era"`
+		} `json:"pitching"`
+	} `json:"seasonStats"`
+}
+
+// IsSubstitute reports whether the player entered the lineup mid-game
+func (p BoxscorePlayer) IsSubstitute() bool {
+	return len(p.BattingOrder) == 3 && !strings.HasSuffix(p.BattingOrder, "00")
+}
+
+// BattingLine represents a player's batting in a single game
+type BattingLine struct {
+	AtBats      int `json:"atBats"`
+	Runs        int `json:"runs"`
+	Hits        int `json:"hits"`
+	HomeRuns    int `json:"homeRuns"`
+	RBI         int `json:"rbi"`
+	BaseOnBalls int `json:"baseOnBalls"`
+	StrikeOuts  int `json:"strikeOuts"`
+	LeftOnBase  int `json:"leftOnBase"`
+}
+
+// PitchingLine represents a pitcher's line in a single game
+type PitchingLine struct {
+	InningsPitched  string `json:"inningsPitched"`
+	Hits            int    `json:"hits"`
+	Runs            int    `json:"runs"`
+	EarnedRuns      int    `json:"earnedRuns"`
+	BaseOnBalls     int    `json:"baseOnBalls"`
+	StrikeOuts      int    `json:"strikeOuts"`
+	HomeRuns        int    `json:"homeRuns"`
+	NumberOfPitches int    `json:"numberOfPitches"`
+	Strikes         int    `json:"strikes"`
+}
+
+// Play represents a single plate appearance and its result
+type Play struct {
+	Result struct {
+		Event       string `json:"event"`
+		EventType   string `json:"eventType"`
+		Description string `json:"description"`
+		RBI         int    `json:"rbi"`
+		AwayScore   int    `json:"awayScore"` // score after the play
+		HomeScore   int    `json:"homeScore"`
+	} `json:"result"`
+	About struct {
+		AtBatIndex    int    `json:"atBatIndex"`
+		HalfInning    string `json:"halfInning"` // top or bottom
+		Inning        int    `json:"inning"`
+		IsScoringPlay bool   `json:"isScoringPlay"`
+		IsComplete    bool   `json:"isComplete"`
+	} `json:"about"`
+	Matchup struct {
+		Batter  PersonRef `json:"batter"`
+		Pitcher PersonRef `json:"pitcher"`
+	} `json:"matchup"`
+}
+
+// Involves reports whether the player batted or pitched in the play
+func (p Play) Involves(playerID int) bool {
+	return p.Matchup.Batter.ID == playerID || p.Matchup.Pitcher.ID == playerID
+}
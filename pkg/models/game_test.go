@@ -0,0 +1,73 @@
+package models
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestBoxscoreLineup(t *testing.T) {
+	data, err := os.ReadFile("testdata/boxscore.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var feed GameFeedResponse
+	if err := json.Unmarshal(data, &feed); err != nil {
+		t.Fatal(err)
+	}
+
+	// The pinch hitter, pinch runner and defensive substitute come last in
+	// batters; the pitcher never batted
+	var got []string
+	for _, p := range feed.LiveData.Boxscore.Teams.Away.Lineup() {
+		name := p.Person.FullName
+		if p.IsSubstitute() {
+			name = "  " + name
+		}
+		got = append(got, name)
+	}
+	want := []string{
+		"Mookie Betts",
+		"Shohei Ohtani",
+		"Freddie Freeman",
+		"  Miguel Rojas",
+		"Teoscar Hernandez",
+		"Max Muncy",
+		"Will Smith",
+		"  Kiké Hernandez",
+		"  Chris Taylor",
+		"Gavin Lux",
+		"Tommy Edman",
+		"Andy Pages",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Lineup() =\n%q\nwant\n%q", got, want)
+	}
+
+	if lineup := feed.LiveData.Boxscore.Teams.Home.Lineup(); len(lineup) != 0 {
+		t.Errorf("Lineup() of a team with no batters = %d players, want none", len(lineup))
+	}
+}
+
+func TestPlayInvolves(t *testing.T) {
+	var p Play
+	p.Matchup.Batter = PersonRef{ID: 592450, FullName: "Aaron Judge"}
+	p.Matchup.Pitcher = PersonRef{ID: 607192, FullName: "Tyler Glasnow"}
+
+	tests := []struct {
+		playerID int
+		want     bool
+	}{
+		{592450, true},
+		{607192, true},
+		{660271, false},
+		{0, false},
+	}
+
+	for _, tt := range tests {
+		if got := p.Involves(tt.playerID); got != tt.want {
+			t.Errorf("Involves(%d) = %v, want %v", tt.playerID, got, tt.want)
+		}
+	}
+}
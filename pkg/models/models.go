@@ -0,0 +1,421 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TeamsResponse represents the API response for teams endpoint
+type TeamsResponse struct {
+	Teams []Team `json:"teams"`
+}
+
+// Team represents an MLB team
+type Team struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Abbreviation string `json:"abbreviation"`
+	Division     struct {
+		Name string `json:"name"`
+	} `json:"division"`
+	League struct {
+		Name string `json:"name"`
+	} `json:"league"`
+	Venue struct {
+		Name string `json:"name"`
+	} `json:"venue"`
+}
+
+// StandingsResponse represents the API response for standings endpoint
+type StandingsResponse struct {
+	Records []StandingsRecord `json:"records"`
+}
+
+// StandingsRecord represents a division's or league's standings
+type StandingsRecord struct {
+	StandingsType string `json:"standingsType"` // e.g. regularSeason, wildCard, byLeague
+	League        struct {
+		ID int `json:"id"`
+	} `json:"league"`
+	Division struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"division"`
+	TeamRecords []TeamRecord `json:"teamRecords"`
+}
+
+// TeamRecord represents a team's record in the standings
+type TeamRecord struct {
+	Team struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"team"`
+	Wins         int    `json:"wins"`
+	Losses       int    `json:"losses"`
+	WinningPct   string `json:"winningPercentage"`
+	GamesBack    string `json:"gamesBack"`
+	DivisionRank string `json:"divisionRank"`
+	LeagueRank   string `json:"leagueRank"`
+
+	WildCardRank      string `json:"wildCardRank"`
+	WildCardGamesBack string `json:"wildCardGamesBack"` // vs the final wild card spot; "+2.0" when ahead of it
+	LeagueGamesBack   string `json:"leagueGamesBack"`
+	Streak            struct {
+		StreakCode string `json:"streakCode"`
+	} `json:"streak"`
+
+	RunsScored      int `json:"runsScored"`
+	RunsAllowed     int `json:"runsAllowed"`
+	RunDifferential int `json:"runDifferential"`
+	Records         struct {
+		SplitRecords    []SplitRecord    `json:"splitRecords"`
+		DivisionRecords []DivisionRecord `json:"divisionRecords"`
+	} `json:"records"`
+}
+
+// Split types in TeamRecord.Records.SplitRecords
+const (
+	SplitHome        = "home"
+	SplitAway        = "away"
+	SplitLastTen     = "lastTen"
+	SplitExtraInning = "extraInning"
+	SplitOneRun      = "oneRun"
+)
+
+// Split returns the team's record for a split type such as SplitHome
+func (r TeamRecord) Split(splitType string) (SplitRecord, bool) {
+	for _, s := range r.Records.SplitRecords {
+		if s.Type == splitType {
+			return s, true
+		}
+	}
+	return SplitRecord{}, false
+}
+
+// VsDivision returns the team's record against a division
+func (r TeamRecord) VsDivision(divisionID int) (DivisionRecord, bool) {
+	for _, d := range r.Records.DivisionRecords {
+		if d.Division.ID == divisionID {
+			return d, true
+		}
+	}
+	return DivisionRecord{}, false
+}
+
+// SplitRecord formats the team's W-L record for a split type, or "-" when
+// the API didn't return it
+func (r TeamRecord) SplitRecord(splitType string) string {
+	if s, ok := r.Split(splitType); ok {
+		return s.String()
+	}
+	return "-"
+}
+
+// DivisionRecord formats the team's W-L record against a division, or "-"
+// when the API didn't return it
+func (r TeamRecord) DivisionRecord(divisionID int) string {
+	if d, ok := r.VsDivision(divisionID); ok {
+		return d.String()
+	}
+	return "-"
+}
+
+// SplitRecord represents a team's record in one situation, such as at home
+type SplitRecord struct {
+	Type   string `json:"type"`
+	Wins   int    `json:"wins"`
+	Losses int    `json:"losses"`
+	Pct    string `json:"pct"`
+}
+
+// String formats the record as W-L
+func (s SplitRecord) String() string {
+	return fmt.Sprintf("%d-%d", s.Wins, s.Losses)
+}
+
+// DivisionRecord represents a team's record against one division
+type DivisionRecord struct {
+	Division struct {
+		ID int `json:"id"`
+	} `json:"division"`
+	Wins   int    `json:"wins"`
+	Losses int    `json:"losses"`
+	Pct    string `json:"pct"`
+}
+
+// String formats the record as W-L
+func (d DivisionRecord) String() string {
+	return fmt.Sprintf("%d-%d", d.Wins, d.Losses)
+}
+
+// ScheduleResponse represents the API response for schedule endpoint
+type ScheduleResponse struct {
+	Dates []ScheduleDate `json:"dates"`
+}
+
+// ScheduleDate represents a single date in the schedule
+type ScheduleDate struct {
+	Date  string         `json:"date"`
+	Games []ScheduleGame `json:"games"`
+}
+
+// ScheduleGame represents a single game
+type ScheduleGame struct {
+	GamePk   int    `json:"gamePk"`
+	GameDate string `json:"gameDate"`
+	GameType string `json:"gameType"`
+	Status   struct {
+		DetailedState string `json:"detailedState"`
+		StartTimeTBD  bool   `json:"startTimeTBD"`
+	} `json:"status"`
+	Teams struct {
+		Away struct {
+			Team struct {
+				ID   int    `json:"id"`
+				Name string `json:"name"`
+			} `json:"team"`
+			Score           int        `json:"score"`
+			ProbablePitcher *PersonRef `json:"probablePitcher"`
+		} `json:"away"`
+		Home struct {
+			Team struct {
+				ID   int    `json:"id"`
+				Name string `json:"name"`
+			} `json:"team"`
+			Score           int        `json:"score"`
+			ProbablePitcher *PersonRef `json:"probablePitcher"`
+		} `json:"home"`
+	} `json:"teams"`
+	Venue struct {
+		Name string `json:"name"`
+	} `json:"venue"`
+	DoubleHeader string        `json:"doubleHeader"` // N, Y (traditional) or S (split)
+	GameNumber   int           `json:"gameNumber"`
+	Decisions    *Decisions    `json:"decisions"`
+	SeriesStatus *SeriesStatus `json:"seriesStatus"`
+}
+
+// StartTime returns the scheduled first pitch, or false when the time has
+// not been announced
+func (g ScheduleGame) StartTime() (time.Time, bool) {
+	if g.Status.StartTimeTBD {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, g.GameDate)
+	return t, err == nil
+}
+
+// LocalStartTime formats the scheduled first pitch in loc, e.g.
+// "7:10 PM EDT", or "TBD" when the time has not been announced
+func (g ScheduleGame) LocalStartTime(loc *time.Location) string {
+	t, ok := g.StartTime()
+	if !ok {
+		return "TBD"
+	}
+	return t.In(loc).Format("3:04 PM MST")
+}
+
+// IsOver reports whether the game has been played to completion
+func (g ScheduleGame) IsOver() bool {
+	switch g.Status.DetailedState {
+	case "Final", "Game Over", "Completed Early":
+		return true
+	}
+	return false
+}
+
+// IsCalledOff reports whether the game was postponed or cancelled.
+// Postponed games appear again in the schedule on their makeup date.
+func (g ScheduleGame) IsCalledOff() bool {
+	switch g.Status.DetailedState {
+	case "Postponed", "Cancelled":
+		return true
+	}
+	return false
+}
+
+// IsDoubleHeader reports whether the game is part of a doubleheader
+func (g ScheduleGame) IsDoubleHeader() bool {
+	return g.DoubleHeader == "Y" || g.DoubleHeader == "S"
+}
+
+// Pitchers summarizes the pitchers of record for a decided game, e.g.
+// "W: Glasnow, L: Cole, S: Treinen", or the probable starters for one that
+// has not been decided, e.g. "Glasnow vs TBD". It is empty when neither is
+// known.
+func (g ScheduleGame) Pitchers() string {
+	if d := g.Decisions; d != nil && (d.Winner != nil || d.Loser != nil) {
+		pitchers := fmt.Sprintf("W: %s, L: %s", pitcherName(d.Winner, "-"), pitcherName(d.Loser, "-"))
+		if d.Save != nil {
+			pitchers += ", S: " + pitcherName(d.Save, "-")
+		}
+		return pitchers
+	}
+
+	away, home := g.Teams.Away.ProbablePitcher, g.Teams.Home.ProbablePitcher
+	if away == nil && home == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s vs %s", pitcherName(away, "TBD"), pitcherName(home, "TBD"))
+}
+
+// Series summarizes a postseason series, e.g. "ALCS Gm 3: NYY leads 2-0",
+// or is empty outside one
+func (g ScheduleGame) Series() string {
+	s := g.SeriesStatus
+	if s == nil || s.TotalGames == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s Gm %d: %s", s.ShortDescription, s.GameNumber, s.Result)
+}
+
+// pitcherName returns a pitcher's name, or missing when there is none
+func pitcherName(p *PersonRef, missing string) string {
+	if p == nil || p.FullName == "" {
+		return missing
+	}
+	return p.FullName
+}
+
+// SeriesStatus represents the state of a postseason series
+type SeriesStatus struct {
+	GameNumber       int    `json:"gameNumber"`
+	TotalGames       int    `json:"totalGames"`
+	IsOver           bool   `json:"isOver"`
+	Result           string `json:"result"` // e.g. "LAD leads 2-1"
+	Description      string `json:"description"`
+	ShortDescription string `json:"shortDescription"`
+}
+
+// PlayerSearchResponse represents the API response for player search
+type PlayerSearchResponse struct {
+	People []Player `json:"people"`
+}
+
+// Player represents an MLB player
+type Player struct {
+	ID              int    `json:"id"`
+	FullName        string `json:"fullName"`
+	PrimaryPosition struct {
+		Abbreviation string `json:"abbreviation"`
+	} `json:"primaryPosition"`
+	CurrentTeam struct {
+		Name string `json:"name"`
+	} `json:"currentTeam"`
+	BatSide struct {
+		Code string `json:"code"`
+	} `json:"batSide"`
+	PitchHand struct {
+		Code string `json:"code"`
+	} `json:"pitchHand"`
+	BirthDate string `json:"birthDate"`
+	Height    string `json:"height"`
+	Weight    int    `json:"weight"`
+	Active    bool   `json:"active"`
+}
+
+// PlayerStatsResponse represents the API response for player stats
+type PlayerStatsResponse struct {
+	People []PlayerWithStats `json:"people"`
+}
+
+// PlayerWithStats represents a player with their statistics
+type PlayerWithStats struct {
+	FullName string      `json:"fullName"`
+	Stats    []StatGroup `json:"stats"`
+}
+
+// StatGroup represents a group of statistics (hitting/pitching)
+type StatGroup struct {
+	Type struct {
+		DisplayName string `json:"displayName"` // e.g. yearByYear, gameLog or vsPlayer
+	} `json:"type"`
+	Group struct {
+		DisplayName string `json:"displayName"`
+	} `json:"group"`
+	Splits []StatSplit `json:"splits"`
+}
+
+// StatSplit represents statistics for a specific season. Stat keeps the
+// API's stat object as is, so JSON output is lossless; the typed field for
+// the split's group is decoded from it.
+type StatSplit struct {
+	Season string          `json:"season"`
+	Stat   json.RawMessage `json:"stat"`
+
+	// Set on situational splits only, e.g. {"vl", "vs Left"}
+	Split *struct {
+		Code        string `json:"code"`
+		Description string `json:"description"`
+	} `json:"split,omitempty"`
+
+	// Set on matchup splits only
+	Batter  *PersonRef `json:"batter,omitempty"`
+	Pitcher *PersonRef `json:"pitcher,omitempty"`
+
+	// Set on game log splits only
+	Date     string     `json:"date,omitempty"`
+	IsHome   *bool      `json:"isHome,omitempty"`
+	IsWin    *bool      `json:"isWin,omitempty"`
+	Player   *PersonRef `json:"player,omitempty"`
+	Opponent *struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"opponent,omitempty"`
+	Game *struct {
+		GamePk int `json:"gamePk"`
+	} `json:"game,omitempty"`
+
+	Hitting  *HittingStats  `json:"-"`
+	Pitching *PitchingStats `json:"-"`
+	Fielding *FieldingStats `json:"-"`
+}
+
+// RosterResponse represents the API response for team roster
+type RosterResponse struct {
+	Roster []RosterEntry `json:"roster"`
+}
+
+// RosterEntry represents a single roster entry
+type RosterEntry struct {
+	Person struct {
+		ID       int    `json:"id"`
+		FullName string `json:"fullName"`
+	} `json:"person"`
+	Position struct {
+		Abbreviation string `json:"abbreviation"`
+	} `json:"position"`
+	JerseyNumber string `json:"jerseyNumber"`
+	Status       struct {
+		Description string `json:"description"`
+	} `json:"status"`
+}
+
+// TeamAbbreviations maps team abbreviations to their IDs
+var TeamAbbreviations = map[string]int{
+	"LAA": 108, "ARI": 109, "BAL": 110, "BOS": 111, "CHC": 112,
+	"CIN": 113, "CLE": 114, "COL": 115, "DET": 116, "HOU": 117,
+	"KC":  118, "LAD": 119, "WSH": 120, "NYM": 121, "OAK": 133,
+	"PIT": 134, "SD":  135, "SEA": 136, "SF":  137, "STL": 138,
+	"TB":  139, "TEX": 140, "TOR": 141, "MIN": 142, "PHI": 143,
+	"ATL": 144, "CWS": 145, "MIA": 146, "NYY": 147, "MIL": 158,
+}
+
+// GetTeamID returns the team ID for a given abbreviation or ID string
+func GetTeamID(input string) (int, bool) {
+	if id, ok := TeamAbbreviations[input]; ok {
+		return id, true
+	}
+	return 0, false
+}
+
+// GetTeamAbbreviation returns the abbreviation for a team ID
+func GetTeamAbbreviation(id int) (string, bool) {
+	for abbr, teamID := range TeamAbbreviations {
+		if teamID == id {
+			return abbr, true
+		}
+	}
+	return "", false
+}
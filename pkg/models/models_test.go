@@ -0,0 +1,98 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleGamePitchers(t *testing.T) {
+	glasnow := &PersonRef{ID: 607192, FullName: "Tyler Glasnow"}
+	cole := &PersonRef{ID: 543037, FullName: "Gerrit Cole"}
+	treinen := &PersonRef{ID: 595014, FullName: "Blake Treinen"}
+
+	tests := []struct {
+		name       string
+		decisions  *Decisions
+		away, home *PersonRef
+		want       string
+	}{
+		{"decided with save", &Decisions{Winner: glasnow, Loser: cole, Save: treinen}, nil, nil, "W: Tyler Glasnow, L: Gerrit Cole, S: Blake Treinen"},
+		{"decided without save", &Decisions{Winner: glasnow, Loser: cole}, cole, glasnow, "W: Tyler Glasnow, L: Gerrit Cole"},
+		{"only a winner", &Decisions{Winner: glasnow}, nil, nil, "W: Tyler Glasnow, L: -"},
+		{"probables", nil, glasnow, cole, "Tyler Glasnow vs Gerrit Cole"},
+		{"one probable", &Decisions{}, nil, cole, "TBD vs Gerrit Cole"},
+		{"nothing known", nil, nil, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g ScheduleGame
+			g.Decisions = tt.decisions
+			g.Teams.Away.ProbablePitcher = tt.away
+			g.Teams.Home.ProbablePitcher = tt.home
+			if got := g.Pitchers(); got != tt.want {
+				t.Errorf("Pitchers() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleGameLocalStartTime(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+
+	tests := []struct {
+		name     string
+		gameDate string
+		tbd      bool
+		loc      *time.Location
+		want     string
+	}{
+		{"eastern", "2024-07-04T23:10:00Z", false, newYork, "7:10 PM EDT"},
+		{"next day in tokyo", "2024-07-04T23:10:00Z", false, tokyo, "8:10 AM JST"},
+		{"time to be announced", "2024-07-04T23:10:00Z", true, newYork, "TBD"},
+		{"unparsable", "July 4th", false, newYork, "TBD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := ScheduleGame{GameDate: tt.gameDate}
+			g.Status.StartTimeTBD = tt.tbd
+			if got := g.LocalStartTime(tt.loc); got != tt.want {
+				t.Errorf("LocalStartTime() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTeamRecordSplits(t *testing.T) {
+	var tr TeamRecord
+	tr.Records.SplitRecords = []SplitRecord{{Type: SplitHome, Wins: 52, Losses: 29}}
+	var vsWest DivisionRecord
+	vsWest.Division.ID = 203
+	vsWest.Wins, vsWest.Losses = 20, 13
+	tr.Records.DivisionRecords = []DivisionRecord{vsWest}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"split", tr.SplitRecord(SplitHome), "52-29"},
+		{"missing split", tr.SplitRecord(SplitOneRun), "-"},
+		{"division", tr.DivisionRecord(203), "20-13"},
+		{"missing division", tr.DivisionRecord(201), "-"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Stat group names, as found in StatGroup.Group.DisplayName
+const (
+	GroupHitting  = "hitting"
+	GroupPitching = "pitching"
+	GroupFielding = "fielding"
+)
+
+// Stat types, as found in StatGroup.Type.DisplayName, for a batter's
+// history against one pitcher: one split per season, and the career total
+const (
+	StatTypeVsPlayer      = "vsPlayer"
+	StatTypeVsPlayerTotal = "vsPlayerTotal"
+)
+
+// StatsResponse represents the API response for a player's game log,
+// situational splits or matchup history
+type StatsResponse struct {
+	Stats []StatGroup `json:"stats"`
+}
+
+// UnmarshalJSON decodes a stat group and types each split's stats by the
+// group's display name
+func (g *StatGroup) UnmarshalJSON(data []byte) error {
+	type plain StatGroup
+	if err := json.Unmarshal(data, (*plain)(g)); err != nil {
+		return err
+	}
+	for i := range g.Splits {
+		if err := g.Splits[i].decode(g.Group.DisplayName); err != nil {
+			return fmt.Errorf("failed to decode %s stats: %w", g.Group.DisplayName, err)
+		}
+	}
+	return nil
+}
+
+// decode fills in the typed stats for a group from the raw stat object
+func (s *StatSplit) decode(group string) error {
+	if len(s.Stat) == 0 {
+		return nil
+	}
+
+	switch group {
+	case GroupHitting:
+		s.Hitting = &HittingStats{}
+		return json.Unmarshal(s.Stat, s.Hitting)
+	case GroupPitching:
+		s.Pitching = &PitchingStats{}
+		return json.Unmarshal(s.Stat, s.Pitching)
+	case GroupFielding:
+		s.Fielding = &FieldingStats{}
+		return json.Unmarshal(s.Stat, s.Fielding)
+	}
+	return nil
+}
+
+// HittingStats represents a player's batting line. Rate stats are strings
+// as the API formats them, e.g. ".310".
+type HittingStats struct {
+	GamesPlayed          int    `json:"gamesPlayed"`
+	PlateAppearances     int    `json:"plateAppearances"`
+	AtBats               int    `json:"atBats"`
+	Runs                 int    `json:"runs"`
+	Hits                 int    `json:"hits"`
+	Doubles              int    `json:"doubles"`
+	Triples              int    `json:"triples"`
+	HomeRuns             int    `json:"homeRuns"`
+	RBI                  int    `json:"rbi"`
+	TotalBases           int    `json:"totalBases"`
+	BaseOnBalls          int    `json:"baseOnBalls"`
+	IntentionalWalks     int    `json:"intentionalWalks"`
+	HitByPitch           int    `json:"hitByPitch"`
+	StrikeOuts           int    `json:"strikeOuts"`
+	SacFlies             int    `json:"sacFlies"`
+	SacBunts             int    `json:"sacBunts"`
+	StolenBases          int    `json:"stolenBases"`
+	CaughtStealing       int    `json:"caughtStealing"`
+	GroundIntoDoublePlay int    `json:"groundIntoDoublePlay"`
+	LeftOnBase           int    `json:"leftOnBase"`
+	Avg                  string `json:"avg"`
+	OBP                  string `json:"obp"`
+	SLG                  string `json:"slg"`
+	OPS                  string `json:"ops"`
+	BABIP                string `json:"babip"`
+}
+
+// PitchingStats represents a pitcher's line. Rate stats and innings
+// pitched are strings as the API formats them, e.g. "3.21" and "180.1".
+type PitchingStats struct {
+	GamesPlayed        int    `json:"gamesPlayed"`
+	GamesStarted       int    `json:"gamesStarted"`
+	Wins               int    `json:"wins"`
+	Losses             int    `json:"losses"`
+	Saves              int    `json:"saves"`
+	SaveOpportunities  int    `json:"saveOpportunities"`
+	Holds              int    `json:"holds"`
+	BlownSaves         int    `json:"blownSaves"`
+	CompleteGames      int    `json:"completeGames"`
+	Shutouts           int    `json:"shutouts"`
+	InningsPitched     string `json:"inningsPitched"`
+	Outs               int    `json:"outs"`
+	BattersFaced       int    `json:"battersFaced"`
+	Hits               int    `json:"hits"`
+	Runs               int    `json:"runs"`
+	EarnedRuns         int    `json:"earnedRuns"`
+	HomeRuns           int    `json:"homeRuns"`
+	BaseOnBalls        int    `json:"baseOnBalls"`
+	IntentionalWalks   int    `json:"intentionalWalks"`
+	HitByPitch         int    `json:"hitByPitch"`
+	StrikeOuts         int    `json:"strikeOuts"`
+	NumberOfPitches    int    `json:"numberOfPitches"`
+	ERA                string `json:"era"`
+	WHIP               string `json:"whip"`
+	StrikeoutsPer9Inn  string `json:"strikeoutsPer9Inn"`
+	WalksPer9Inn       string `json:"walksPer9Inn"`
+	HitsPer9Inn        string `json:"hitsPer9Inn"`
+	StrikeoutWalkRatio string `json:"strikeoutWalkRatio"`
+	Avg                string `json:"avg"` // opponents' batting line
+	OBP                string `json:"obp"`
+	SLG                string `json:"slg"`
+	OPS                string `json:"ops"`
+}
+
+// TotalOuts returns the outs recorded, falling back to innings pitched as
+// the API writes them, where "180.1" is 180 innings and one out
+func (p *PitchingStats) TotalOuts() int {
+	if p.Outs > 0 {
+		return p.Outs
+	}
+	whole, thirds, _ := strings.Cut(p.InningsPitched, ".")
+	innings, err := strconv.Atoi(whole)
+	if err != nil {
+		return 0
+	}
+	outs, _ := strconv.Atoi(thirds)
+	return innings*3 + outs
+}
+
+// FieldingStats represents a player's fielding at one position
+type FieldingStats struct {
+	Position struct {
+		Abbreviation string `json:"abbreviation"`
+	} `json:"position"`
+	GamesPlayed        int    `json:"gamesPlayed"`
+	GamesStarted       int    `json:"gamesStarted"`
+	Innings            string `json:"innings"`
+	Chances            int    `json:"chances"`
+	PutOuts            int    `json:"putOuts"`
+	Assists            int    `json:"assists"`
+	Errors             int    `json:"errors"`
+	DoublePlays        int    `json:"doublePlays"`
+	Fielding           string `json:"fielding"` // fielding percentage, e.g. ".985"
+	RangeFactorPerGame string `json:"rangeFactorPerGame"`
+}
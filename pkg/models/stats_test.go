@@ -0,0 +1,65 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestStatGroupDecode(t *testing.T) {
+	data := `{"stats": [
+		{"group": {"displayName": "hitting"}, "splits": [{"season": "2024", "stat": {"homeRuns": 58, "avg": ".322"}}]},
+		{"group": {"displayName": "pitching"}, "splits": [{"season": "2024", "stat": {"inningsPitched": "192.0", "era": "2.39"}}]},
+		{"group": {"displayName": "fielding"}, "splits": [{"season": "2024", "stat": {"position": {"abbreviation": "CF"}, "errors": 4}}]},
+		{"group": {"displayName": "catching"}, "splits": [{"season": "2024", "stat": {"passedBall": 3}}]},
+		{"group": {"displayName": "hitting"}, "splits": [{"season": "2023"}]}
+	]}`
+
+	var resp StatsResponse
+	if err := json.Unmarshal([]byte(data), &resp); err != nil {
+		t.Fatal(err)
+	}
+	split := func(i int) StatSplit { return resp.Stats[i].Splits[0] }
+
+	if h := split(0).Hitting; h == nil || h.HomeRuns != 58 || h.Avg != ".322" || split(0).Pitching != nil {
+		t.Errorf("hitting split = %+v", split(0))
+	}
+	if p := split(1).Pitching; p == nil || p.TotalOuts() != 576 || p.ERA != "2.39" || split(1).Hitting != nil {
+		t.Errorf("pitching split = %+v", split(1))
+	}
+	if f := split(2).Fielding; f == nil || f.Position.Abbreviation != "CF" || f.Errors != 4 {
+		t.Errorf("fielding split = %+v", split(2))
+	}
+	// Unknown groups and splits without a stat object keep only the raw stat
+	for _, i := range []int{3, 4} {
+		if s := split(i); s.Hitting != nil || s.Pitching != nil || s.Fielding != nil {
+			t.Errorf("split %d decoded typed stats: %+v", i, s)
+		}
+	}
+
+	bad := `{"stats": [{"group": {"displayName": "hitting"}, "splits": [{"stat": {"homeRuns": "many"}}]}]}`
+	err := json.Unmarshal([]byte(bad), &resp)
+	if err == nil || !strings.Contains(err.Error(), "failed to decode hitting stats") {
+		t.Errorf("decoding a malformed hitting line: error = %v", err)
+	}
+}
+
+func TestTotalOuts(t *testing.T) {
+	tests := []struct {
+		stats PitchingStats
+		want  int
+	}{
+		{PitchingStats{Outs: 541, InningsPitched: "1.0"}, 541},
+		{PitchingStats{InningsPitched: "180.1"}, 541},
+		{PitchingStats{InningsPitched: "0.2"}, 2},
+		{PitchingStats{InningsPitched: "7"}, 21},
+		{PitchingStats{InningsPitched: ""}, 0},
+		{PitchingStats{InningsPitched: "-.--"}, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.stats.TotalOuts(); got != tt.want {
+			t.Errorf("TotalOuts(%+v) = %d, want %d", tt.stats, got, tt.want)
+		}
+	}
+}